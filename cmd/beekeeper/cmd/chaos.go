@@ -0,0 +1,29 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func (c *command) initChaosCmd() (err error) {
+	cmd := &cobra.Command{
+		Use:   "chaos",
+		Short: "Inspect and clean up chaos experiments created by beekeeper",
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			return cmd.Help()
+		},
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return c.config.BindPFlags(cmd.Flags())
+		},
+	}
+	viper.AutomaticEnv()
+	cmd.PersistentFlags().String(optionNameKubeConfig, viper.GetString("KUBECONFIG"), "kubernetes config file")
+	cmd.PersistentFlags().StringP(optionNameChaosNamespace, "n", "", "kubernetes namespace of the chaos experiment targets, all namespaces if not set")
+
+	cmd.AddCommand(c.initChaosList())
+	cmd.AddCommand(c.initChaosStatus())
+	cmd.AddCommand(c.initChaosCleanup())
+
+	c.root.AddCommand(cmd)
+	return nil
+}
@@ -0,0 +1,31 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/ethersphere/beekeeper/pkg/chaos"
+
+	"github.com/spf13/cobra"
+)
+
+func (c *command) initChaosCleanup() *cobra.Command {
+	return &cobra.Command{
+		Use:   "cleanup",
+		Short: "Deletes chaos experiments",
+		Long: `Deletes all chaos experiments created by beekeeper whose targets are
+in the namespace, or in all namespaces if namespace is not set.`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			deleted, err := chaos.Cleanup(cmd.Context(), c.config.GetString(optionNameKubeConfig), c.config.GetString(optionNameChaosNamespace))
+			for _, name := range deleted {
+				fmt.Printf("chaos experiment %s deleted\n", name)
+			}
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("%d chaos experiments deleted\n", len(deleted))
+			return
+		},
+		PreRunE: c.turnPreRunE,
+	}
+}
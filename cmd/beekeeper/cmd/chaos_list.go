@@ -0,0 +1,38 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/ethersphere/beekeeper/pkg/chaos"
+
+	"github.com/spf13/cobra"
+)
+
+func (c *command) initChaosList() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "Lists chaos experiments",
+		Long:  `Lists chaos experiments created by beekeeper with their phase and age.`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			statuses, err := chaos.List(cmd.Context(), c.config.GetString(optionNameKubeConfig), c.config.GetString(optionNameChaosNamespace))
+			if err != nil {
+				return err
+			}
+			if len(statuses) == 0 {
+				fmt.Println("no chaos experiments")
+				return
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "NAME\tKIND\tACTION\tNAMESPACE\tPHASE\tAGE")
+			for _, s := range statuses {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", s.Name, s.Kind, s.Action, s.TargetNamespace, s.Phase, time.Since(s.Created).Round(time.Second))
+			}
+			return w.Flush()
+		},
+		PreRunE: c.turnPreRunE,
+	}
+}
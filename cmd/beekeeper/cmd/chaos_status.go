@@ -0,0 +1,39 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/ethersphere/beekeeper/pkg/chaos"
+
+	"github.com/spf13/cobra"
+)
+
+func (c *command) initChaosStatus() *cobra.Command {
+	return &cobra.Command{
+		Use:   "status <name>",
+		Short: "Prints status of chaos experiment",
+		Long:  `Prints status of chaos experiment created by beekeeper, including its status conditions.`,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			s, err := chaos.GetStatus(cmd.Context(), c.config.GetString(optionNameKubeConfig), args[0])
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("Name: %s\n", s.Name)
+			fmt.Printf("Kind: %s\n", s.Kind)
+			fmt.Printf("Action: %s\n", s.Action)
+			fmt.Printf("Namespace: %s\n", s.TargetNamespace)
+			fmt.Printf("Created: %s\n", s.Created)
+			fmt.Printf("Phase: %s\n", s.Phase)
+			if s.FailedMessage != "" {
+				fmt.Printf("Failed: %s\n", s.FailedMessage)
+			}
+			for _, cond := range s.Conditions {
+				fmt.Printf("Condition %s: %s\n", cond.Type, cond.Status)
+			}
+			return
+		},
+		PreRunE: c.turnPreRunE,
+	}
+}
@@ -1,8 +1,11 @@
 package cmd
 
 import (
+	"fmt"
 	"time"
 
+	"github.com/ethersphere/beekeeper/pkg/chaos"
+	"github.com/ethersphere/beekeeper/pkg/ledger"
 	"github.com/spf13/cobra"
 )
 
@@ -27,6 +30,14 @@ const (
 	optionNamePostageDepth            = "postage-depth"
 	optionNamePostageBatchhWait       = "postage-wait"
 	optionNameCacheCapacity           = "cache-capacity"
+	optionNameLedger                  = "ledger"
+	optionNameChaos                   = "chaos"
+	optionNameChaosWait               = "chaos-wait"
+	optionNameChaosBackend            = "chaos-backend"
+	optionNameBeeBackend              = "bee-backend"
+	optionNameBeeChart                = "bee-chart"
+	optionNameBeeChartVersion         = "bee-chart-version"
+	optionNameBeeChartValues          = "bee-chart-values"
 )
 
 var (
@@ -65,6 +76,10 @@ func (c *command) initCheckCmd() (err error) {
 	cmd.PersistentFlags().BoolVar(&pushMetrics, optionNamePushMetrics, false, "push metrics to pushgateway")
 	cmd.PersistentFlags().BoolVar(&inCluster, optionNameInCluster, false, "run Beekeeper in Kubernetes cluster")
 	cmd.PersistentFlags().String(optionNameKubeconfig, "", "kubernetes config file")
+	cmd.PersistentFlags().String(optionNameBeeBackend, beeBackendK8S, "backend that manages Bee nodes in the kubernetes cluster [k8s|helm]")
+	cmd.PersistentFlags().String(optionNameBeeChart, "ethersphere/bee", "Bee helm chart reference, path or URL, used by helm backend")
+	cmd.PersistentFlags().String(optionNameBeeChartVersion, "", "Bee helm chart version, latest if not specified, used by helm backend")
+	cmd.PersistentFlags().StringSlice(optionNameBeeChartValues, nil, "Bee helm chart values files, comma separated or specified multiple times, used by helm backend")
 	cmd.PersistentFlags().Int64(optionNamePostageAmount, 1, "postage stamp amount")
 	// CICD options
 	cmd.PersistentFlags().BoolVar(&clefSignerEnable, optionNameClefSignerEnable, false, "enable Clef signer")
@@ -78,9 +93,13 @@ func (c *command) initCheckCmd() (err error) {
 	cmd.PersistentFlags().Uint64Var(&swapInitialDeposit, optionNameSwapInitialDeposit, 500000000000000000, "swap initial deposit")
 	cmd.PersistentFlags().StringVar(&nodeSelector, optionNameNodeSelector, "bee-staging", "node selector")
 	cmd.PersistentFlags().StringVar(&ingressClass, optionNameIngressClass, "nginx-internal", "ingress class")
-	cmd.PersistentFlags().Duration(optionNamePostageBatchhWait, time.Second*5, "time to wait for batch to be mined")
+	cmd.PersistentFlags().Duration(optionNamePostageBatchhWait, time.Minute*5, "maximum time to wait for batch to become usable")
 	cmd.PersistentFlags().Int(optionNameCacheCapacity, 1000, "cache capacity in chunks")
 	cmd.PersistentFlags().Uint64(optionNamePostageDepth, 16, "default depth for postage batches")
+	cmd.PersistentFlags().String(optionNameLedger, "", "path to the ledger file in which uploaded content is recorded")
+	cmd.PersistentFlags().String(optionNameChaos, "", "chaos experiment to run during the check, scenario[:key=value,...], e.g. networkdelay:mode=all,latency=100ms")
+	cmd.PersistentFlags().String(optionNameChaosBackend, chaos.BackendChaosMesh, "backend that runs the chaos experiment [chaos-mesh|native]")
+	cmd.PersistentFlags().Duration(optionNameChaosWait, 2*time.Minute, "maximum time to wait for chaos experiment to take effect")
 
 	cmd.AddCommand(c.initCheckBalances())
 	cmd.AddCommand(c.initCheckFileRetrieval())
@@ -100,6 +119,19 @@ func (c *command) initCheckCmd() (err error) {
 	cmd.AddCommand(c.initCheckSmoke())
 	cmd.AddCommand(c.initCheckPSS())
 	cmd.AddCommand(c.initCheckSOC())
+	cmd.AddCommand(c.initCheckFeeds())
+	cmd.AddCommand(c.initCheckPinning())
+	cmd.AddCommand(c.initCheckTags())
+	cmd.AddCommand(c.initCheckReconnect())
+	cmd.AddCommand(c.initCheckChequebook())
+	cmd.AddCommand(c.initCheckPostage())
+	cmd.AddCommand(c.initCheckBatches())
+	cmd.AddCommand(c.initCheckWebsite())
+	cmd.AddCommand(c.initCheckDurability())
+
+	for _, check := range cmd.Commands() {
+		check.RunE = c.withLedger(check.RunE)
+	}
 
 	c.root.AddCommand(cmd)
 	return nil
@@ -123,5 +155,33 @@ func (c *command) checkPreRunE(cmd *cobra.Command, args []string) (err error) {
 		insecureTLSDebugAPI = true
 	}
 
+	return c.openLedger(cmd)
+}
+
+// openLedger opens ledger of uploaded content if its path is set
+func (c *command) openLedger(cmd *cobra.Command) (err error) {
+	path := c.config.GetString(optionNameLedger)
+	if path == "" {
+		return
+	}
+
+	if c.ledger, err = ledger.Open(path); err != nil {
+		return err
+	}
+	c.ledger.SetSource(cmd.CommandPath())
+
 	return
 }
+
+// withLedger closes the ledger opened in the pre-run once run returns
+func (c *command) withLedger(run func(cmd *cobra.Command, args []string) error) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) (err error) {
+		defer func() {
+			if cerr := c.ledger.Close(); cerr != nil && err == nil {
+				err = fmt.Errorf("close ledger: %w", cerr)
+			}
+		}()
+
+		return run(cmd, args)
+	}
+}
@@ -71,6 +71,11 @@ func (c *command) initCheckBalances() *cobra.Command {
 				return fmt.Errorf("creating Kubernetes client: %w", err)
 			}
 
+			k8sBee, err := c.newK8SBee(k8sClient)
+			if err != nil {
+				return fmt.Errorf("creating Kubernetes Bee client: %w", err)
+			}
+
 			namespace := c.config.GetString(optionNameNamespace)
 			cluster := bee.NewCluster(clusterName, bee.ClusterOptions{
 				APIDomain:           c.config.GetString(optionNameAPIDomain),
@@ -80,8 +85,10 @@ func (c *command) initCheckBalances() *cobra.Command {
 				DebugAPIInsecureTLS: insecureTLSDebugAPI,
 				DebugAPIScheme:      c.config.GetString(optionNameDebugAPIScheme),
 				K8SClient:           k8sClient,
+				K8SBee:              k8sBee,
 				Namespace:           namespace,
 				DisableNamespace:    disableNamespace,
+				Ledger:              c.ledger,
 			})
 
 			cicd := newCICDOptions(clefSignerEnable, dbCapacity, paymentEarly, paymentThreshold, paymentTolerance, swapEnable, swapEndpoint, swapFactoryAddress, swapInitialDeposit, nodeSelector, ingressClass)
@@ -137,7 +144,9 @@ func (c *command) initCheckBalances() *cobra.Command {
 			pusher := push.New(c.config.GetString(optionNamePushGateway), namespace)
 
 			if dryRun {
-				return balances.DryRunCheck(cluster, balances.Options{})
+				return c.withChaos(cmd, func() error {
+					return balances.DryRunCheck(cluster, balances.Options{})
+				})
 			}
 
 			var seed int64
@@ -146,18 +155,21 @@ func (c *command) initCheckBalances() *cobra.Command {
 			} else {
 				seed = random.Int64()
 			}
+			c.ledger.SetSeed(seed)
 
 			fileSize := round(c.config.GetFloat64(optionNameFileSize) * 1024 * 1024)
 
-			return balances.Check(cluster, balances.Options{
-				UploadNodeCount:    c.config.GetInt(optionNameUploadNodeCount),
-				FileName:           c.config.GetString(optionNameFileName),
-				FileSize:           fileSize,
-				Seed:               seed,
-				WaitBeforeDownload: c.config.GetInt(optionNameWaitBeforeDownload),
-				PostageAmount:      c.config.GetInt64(optionNamePostageAmount),
-				PostageWait:        c.config.GetDuration(optionNamePostageBatchhWait),
-			}, pusher, c.config.GetBool(optionNamePushMetrics))
+			return c.withChaos(cmd, func() error {
+				return balances.Check(cluster, balances.Options{
+					UploadNodeCount:    c.config.GetInt(optionNameUploadNodeCount),
+					FileName:           c.config.GetString(optionNameFileName),
+					FileSize:           fileSize,
+					Seed:               seed,
+					WaitBeforeDownload: c.config.GetInt(optionNameWaitBeforeDownload),
+					PostageAmount:      c.config.GetInt64(optionNamePostageAmount),
+					PostageWait:        c.config.GetDuration(optionNamePostageBatchhWait),
+				}, pusher, c.config.GetBool(optionNamePushMetrics))
+			})
 		},
 		PreRunE: c.checkPreRunE,
 	}
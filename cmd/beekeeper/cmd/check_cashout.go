@@ -24,6 +24,7 @@ func (c *command) initCheckCashout() *cobra.Command {
 				DebugAPIScheme:      c.config.GetString(optionNameDebugAPIScheme),
 				Namespace:           c.config.GetString(optionNameNamespace),
 				DisableNamespace:    disableNamespace,
+				Ledger:              c.ledger,
 			})
 
 			ngOptions := newDefaultNodeGroupOptions()
@@ -36,8 +37,10 @@ func (c *command) initCheckCashout() *cobra.Command {
 				}
 			}
 
-			return cashout.Check(cluster, cashout.Options{
-				NodeGroup: "nodes",
+			return c.withChaos(cmd, func() error {
+				return cashout.Check(cluster, cashout.Options{
+					NodeGroup: "nodes",
+				})
 			})
 		},
 		PreRunE: c.checkPreRunE,
@@ -0,0 +1,96 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/ethersphere/beekeeper/pkg/chaos"
+	"github.com/spf13/cobra"
+)
+
+// chaosCleanupTimeout is the time given to delete chaos experiment
+const chaosCleanupTimeout = time.Minute
+
+// withChaos runs the check while the chaos experiment set by the chaos flag
+// is active. It is called once the cluster is set up, so that the experiment
+// does not disrupt starting the cluster. The experiment is created and
+// awaited before the check, and deleted after the check in every case: on
+// success, error, panic, timeout and interrupt.
+func (c *command) withChaos(cmd *cobra.Command, run func() error) (err error) {
+	spec := c.config.GetString(optionNameChaos)
+	if spec == "" {
+		return run()
+	}
+
+	experiment, err := chaos.ParseSpec(spec)
+	if err != nil {
+		return err
+	}
+
+	namespace := c.config.GetString(optionNameNamespace)
+	backend, err := newChaosBackend(c.config.GetString(optionNameChaosBackend), c.config.GetString(optionNameKubeconfig), c.config.GetBool(optionNameInCluster), namespace)
+	if err != nil {
+		return err
+	}
+	name := chaos.ObjectName(experiment, namespace)
+
+	// interrupt must not kill the process before the experiment is
+	// deleted, it is handled once the experiment is created
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(signals)
+
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if err := backend.Check(ctx); err != nil {
+		return err
+	}
+	if err := backend.Create(ctx, experiment); err != nil {
+		return fmt.Errorf("create chaos experiment %s: %w", name, err)
+	}
+	fmt.Printf("chaos experiment %s created\n", name)
+
+	var cleanupOnce sync.Once
+	cleanup := func() {
+		cleanupOnce.Do(func() {
+			ctx, cancel := context.WithTimeout(context.Background(), chaosCleanupTimeout)
+			defer cancel()
+
+			if err := backend.Delete(ctx, experiment); err != nil {
+				fmt.Fprintf(os.Stderr, "error: delete chaos experiment %s: %v\n", name, err)
+				return
+			}
+			fmt.Printf("chaos experiment %s deleted\n", name)
+		})
+	}
+	// deferred cleanup also runs while panicking
+	defer cleanup()
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case s := <-signals:
+			fmt.Fprintf(os.Stderr, "received %s, deleting chaos experiment %s\n", s, name)
+			cleanup()
+			os.Exit(130)
+		case <-done:
+		}
+	}()
+
+	wCtx, wCancel := context.WithTimeout(ctx, c.config.GetDuration(optionNameChaosWait))
+	defer wCancel()
+	if err := backend.WaitInjected(wCtx, experiment); err != nil {
+		return err
+	}
+	fmt.Printf("chaos experiment %s is active\n", name)
+
+	return run()
+}
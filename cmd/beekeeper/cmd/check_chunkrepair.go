@@ -67,6 +67,11 @@ and attempts repairing of those chunks for the other nodes in the cluster.`,
 				return fmt.Errorf("creating Kubernetes client: %w", err)
 			}
 
+			k8sBee, err := c.newK8SBee(k8sClient)
+			if err != nil {
+				return fmt.Errorf("creating Kubernetes Bee client: %w", err)
+			}
+
 			namespace := c.config.GetString(optionNameNamespace)
 			cluster := bee.NewCluster(clusterName, bee.ClusterOptions{
 				APIDomain:           c.config.GetString(optionNameAPIDomain),
@@ -76,8 +81,10 @@ and attempts repairing of those chunks for the other nodes in the cluster.`,
 				DebugAPIInsecureTLS: insecureTLSDebugAPI,
 				DebugAPIScheme:      c.config.GetString(optionNameDebugAPIScheme),
 				K8SClient:           k8sClient,
+				K8SBee:              k8sBee,
 				Namespace:           namespace,
 				DisableNamespace:    disableNamespace,
+				Ledger:              c.ledger,
 			})
 
 			cicd := newCICDOptions(clefSignerEnable, dbCapacity, paymentEarly, paymentThreshold, paymentTolerance, swapEnable, swapEndpoint, swapFactoryAddress, swapInitialDeposit, nodeSelector, ingressClass)
@@ -138,14 +145,17 @@ and attempts repairing of those chunks for the other nodes in the cluster.`,
 			} else {
 				seed = random.Int64()
 			}
-
-			return chunkrepair.Check(cluster, chunkrepair.Options{
-				NodeGroup:              "bee",
-				NumberOfChunksToRepair: c.config.GetInt(optionNumberOfChunks),
-				Seed:                   seed,
-				PostageAmount:          c.config.GetInt64(optionNamePostageAmount),
-				PostageWait:            c.config.GetDuration(optionNamePostageBatchhWait),
-			}, pusher, c.config.GetBool(optionNamePushMetrics))
+			c.ledger.SetSeed(seed)
+
+			return c.withChaos(cmd, func() error {
+				return chunkrepair.Check(cluster, chunkrepair.Options{
+					NodeGroup:              "bee",
+					NumberOfChunksToRepair: c.config.GetInt(optionNumberOfChunks),
+					Seed:                   seed,
+					PostageAmount:          c.config.GetInt64(optionNamePostageAmount),
+					PostageWait:            c.config.GetDuration(optionNamePostageBatchhWait),
+				}, pusher, c.config.GetBool(optionNamePushMetrics))
+			})
 
 		},
 		PreRunE: c.checkPreRunE,
@@ -0,0 +1,199 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ethersphere/beekeeper/pkg/bee"
+	"github.com/ethersphere/beekeeper/pkg/check/durability"
+	"github.com/ethersphere/beekeeper/pkg/random"
+
+	"github.com/spf13/cobra"
+)
+
+func (c *command) initCheckDurability() *cobra.Command {
+	const (
+		optionNameSeed                     = "seed"
+		optionNameSamples                  = "samples"
+		optionNameMinAge                   = "min-age"
+		optionNameAgeBuckets               = "age-buckets"
+		optionNameMinRetrievable           = "min-retrievable"
+		optionNameRetries                  = "retries"
+		optionNameRetryDelay               = "retry-delay"
+		optionNameStartCluster             = "start-cluster"
+		optionNameClusterName              = "cluster-name"
+		optionNameBootnodeCount            = "bootnode-count"
+		optionNameNodeCount                = "node-count"
+		optionNameImage                    = "bee-image"
+		optionNamePersistence              = "persistence"
+		optionNameStorageClass             = "storage-class"
+		optionNameStorageRequest           = "storage-request"
+		optionNameFullNode                 = "full-node"
+		optionNameAdditionalNodeCount      = "additional-node-count"
+		optionNameAdditionalImage          = "additional-bee-image"
+		optionNameAdditionalFullNode       = "additional-full-node"
+		optionNameAdditionalPersistence    = "additional-persistence"
+		optionNameAdditionalStorageClass   = "additional-storage-class"
+		optionNameAdditionalStorageRequest = "additional-storage-request"
+		optionNameImagePullSecrets         = "image-pull-secrets"
+	)
+
+	var (
+		imagePullSecrets         []string
+		startCluster             bool
+		clusterName              string
+		bootnodeCount            int
+		nodeCount                int
+		image                    string
+		persistence              bool
+		storageClass             string
+		storageRequest           string
+		fullNode                 bool
+		additionalNodeCount      int
+		additionalImage          string
+		additionalFullNode       bool
+		additionalPersistence    bool
+		additionalStorageClass   string
+		additionalStorageRequest string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "durability",
+		Short: "Checks retrievability of content recorded in the ledger",
+		Long: `Checks retrievability of content recorded in the ledger.
+It downloads content uploaded by previous check and stress runs, as recorded in the
+ledger file given by the ledger flag, from random nodes other than the uploader.
+It reports retrievability by age and fails if the percentage of retrievable
+content is below the minimum.`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			k8sClient, err := setK8SClient(c.config.GetString(optionNameKubeconfig), c.config.GetBool(optionNameInCluster))
+			if err != nil {
+				return fmt.Errorf("creating Kubernetes client: %w", err)
+			}
+
+			k8sBee, err := c.newK8SBee(k8sClient)
+			if err != nil {
+				return fmt.Errorf("creating Kubernetes Bee client: %w", err)
+			}
+
+			namespace := c.config.GetString(optionNameNamespace)
+			cluster := bee.NewCluster(clusterName, bee.ClusterOptions{
+				APIDomain:           c.config.GetString(optionNameAPIDomain),
+				APIInsecureTLS:      insecureTLSAPI,
+				APIScheme:           c.config.GetString(optionNameAPIScheme),
+				DebugAPIDomain:      c.config.GetString(optionNameDebugAPIDomain),
+				DebugAPIInsecureTLS: insecureTLSDebugAPI,
+				DebugAPIScheme:      c.config.GetString(optionNameDebugAPIScheme),
+				K8SClient:           k8sClient,
+				K8SBee:              k8sBee,
+				Namespace:           namespace,
+				DisableNamespace:    disableNamespace,
+				Ledger:              c.ledger,
+			})
+
+			cicd := newCICDOptions(clefSignerEnable, dbCapacity, paymentEarly, paymentThreshold, paymentTolerance, swapEnable, swapEndpoint, swapFactoryAddress, swapInitialDeposit, nodeSelector, ingressClass)
+
+			if startCluster {
+				// bootnodes group
+				bgName := "bootnode"
+				bCtx, bCancel := context.WithTimeout(cmd.Context(), 10*time.Minute)
+				defer bCancel()
+				if err := startBootNodeGroup(bCtx, cluster, bootnodeCount, nodeCount, bgName, namespace, image, storageClass, storageRequest, imagePullSecrets, persistence, cicd); err != nil {
+					return fmt.Errorf("starting bootnode group %s: %w", bgName, err)
+				}
+
+				// node groups
+				ngName := "bee"
+				nCtx, nCancel := context.WithTimeout(cmd.Context(), 10*time.Minute)
+				defer nCancel()
+				if err := startNodeGroup(nCtx, cluster, bootnodeCount, nodeCount, ngName, namespace, image, storageClass, storageRequest, imagePullSecrets, persistence, fullNode, cicd); err != nil {
+					return fmt.Errorf("starting node group %s: %w", ngName, err)
+				}
+
+				if additionalNodeCount > 0 {
+					addNgName := "drone"
+					addNCtx, addNCancel := context.WithTimeout(cmd.Context(), 10*time.Minute)
+					defer addNCancel()
+					if err := startNodeGroup(addNCtx, cluster, bootnodeCount, additionalNodeCount, addNgName, namespace, additionalImage, additionalStorageClass, additionalStorageRequest, imagePullSecrets, additionalPersistence, additionalFullNode, cicd); err != nil {
+						return fmt.Errorf("starting node group %s: %w", addNgName, err)
+					}
+				}
+			} else {
+				// bootnodes group
+				if bootnodeCount > 0 {
+					bgName := "bootnode"
+					if err := addBootNodeGroup(cluster, bootnodeCount, nodeCount, bgName, namespace, image, storageClass, storageRequest, persistence); err != nil {
+						return fmt.Errorf("adding bootnode group %s: %w", bgName, err)
+					}
+				}
+
+				// node groups
+				ngName := "bee"
+				if err := addNodeGroup(cluster, bootnodeCount, nodeCount, ngName, namespace, image, storageClass, storageRequest, persistence); err != nil {
+					return fmt.Errorf("adding node group %s: %w", ngName, err)
+				}
+
+				if additionalNodeCount > 0 {
+					addNgName := "drone"
+					if err := addNodeGroup(cluster, bootnodeCount, additionalNodeCount, addNgName, namespace, additionalImage, additionalStorageClass, additionalStorageRequest, additionalPersistence); err != nil {
+						return fmt.Errorf("starting node group %s: %w", addNgName, err)
+					}
+				}
+			}
+
+			var seed int64
+			if cmd.Flags().Changed("seed") {
+				seed = c.config.GetInt64(optionNameSeed)
+			} else {
+				seed = random.Int64()
+			}
+			c.ledger.SetSeed(seed)
+
+			ageBuckets, err := cmd.Flags().GetDurationSlice(optionNameAgeBuckets)
+			if err != nil {
+				return fmt.Errorf("parsing age buckets: %w", err)
+			}
+
+			return c.withChaos(cmd, func() error {
+				return durability.Check(cluster, durability.Options{
+					Ledger:         c.ledger,
+					Seed:           seed,
+					Samples:        c.config.GetInt(optionNameSamples),
+					MinAge:         c.config.GetDuration(optionNameMinAge),
+					AgeBuckets:     ageBuckets,
+					MinRetrievable: c.config.GetFloat64(optionNameMinRetrievable),
+					Retries:        c.config.GetInt(optionNameRetries),
+					RetryDelay:     c.config.GetDuration(optionNameRetryDelay),
+				})
+			})
+		},
+		PreRunE: c.checkPreRunE,
+	}
+
+	cmd.Flags().Int64P(optionNameSeed, "s", 0, "seed for sampling ledger entries and choosing nodes; if not set, will be random")
+	cmd.Flags().Int(optionNameSamples, 0, "number of ledger entries to verify; if not set, all entries are verified")
+	cmd.Flags().Duration(optionNameMinAge, 0, "minimum age of verified ledger entries")
+	cmd.Flags().DurationSlice(optionNameAgeBuckets, []time.Duration{time.Hour, 24 * time.Hour, 7 * 24 * time.Hour}, "upper bounds of age buckets for durability report")
+	cmd.Flags().Float64(optionNameMinRetrievable, 100, "minimum percentage of retrievable ledger entries")
+	cmd.Flags().Int(optionNameRetries, 3, "number of download retries")
+	cmd.Flags().Duration(optionNameRetryDelay, 5*time.Second, "delay between download retries")
+	cmd.Flags().BoolVar(&startCluster, optionNameStartCluster, false, "start new cluster")
+	cmd.Flags().StringVar(&clusterName, optionNameClusterName, "beekeeper", "cluster name")
+	cmd.Flags().IntVarP(&bootnodeCount, optionNameBootnodeCount, "b", 0, "number of bootnodes")
+	cmd.Flags().IntVarP(&nodeCount, optionNameNodeCount, "c", 1, "number of nodes")
+	cmd.Flags().StringVar(&image, optionNameImage, "ethersphere/bee:latest", "Bee Docker image")
+	cmd.PersistentFlags().BoolVar(&persistence, optionNamePersistence, false, "use persistent storage")
+	cmd.PersistentFlags().StringVar(&storageClass, optionNameStorageClass, "local-storage", "storage class name")
+	cmd.PersistentFlags().StringVar(&storageRequest, optionNameStorageRequest, "34Gi", "storage request")
+	cmd.PersistentFlags().BoolVar(&fullNode, optionNameFullNode, true, "start node in full mode")
+	cmd.Flags().IntVar(&additionalNodeCount, optionNameAdditionalNodeCount, 0, "number of nodes in additional node group")
+	cmd.Flags().StringVar(&additionalImage, optionNameAdditionalImage, "ethersphere/bee:latest", "Bee Docker image in additional node group")
+	cmd.PersistentFlags().BoolVar(&additionalFullNode, optionNameAdditionalFullNode, false, "start node in full mode")
+	cmd.PersistentFlags().BoolVar(&additionalPersistence, optionNameAdditionalPersistence, false, "use persistent storage")
+	cmd.PersistentFlags().StringVar(&additionalStorageClass, optionNameAdditionalStorageClass, "local-storage", "storage class name")
+	cmd.PersistentFlags().StringVar(&additionalStorageRequest, optionNameAdditionalStorageRequest, "34Gi", "storage request")
+	cmd.Flags().StringArrayVar(&imagePullSecrets, optionNameImagePullSecrets, []string{"regcred"}, "image pull secrets")
+
+	return cmd
+}
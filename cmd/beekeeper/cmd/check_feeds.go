@@ -0,0 +1,188 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ethersphere/beekeeper/pkg/bee"
+	"github.com/ethersphere/beekeeper/pkg/check/feeds"
+	"github.com/ethersphere/beekeeper/pkg/random"
+
+	"github.com/spf13/cobra"
+)
+
+func (c *command) initCheckFeeds() *cobra.Command {
+	const (
+		optionNameSeed                     = "seed"
+		optionNameUpdateCount              = "update-count"
+		optionNameUpdateSize               = "update-size"
+		optionNameTimeout                  = "timeout"
+		optionNameRetryDelay               = "retry-delay"
+		optionNameStartCluster             = "start-cluster"
+		optionNameClusterName              = "cluster-name"
+		optionNameBootnodeCount            = "bootnode-count"
+		optionNameNodeCount                = "node-count"
+		optionNameImage                    = "bee-image"
+		optionNamePersistence              = "persistence"
+		optionNameStorageClass             = "storage-class"
+		optionNameStorageRequest           = "storage-request"
+		optionNameFullNode                 = "full-node"
+		optionNameAdditionalNodeCount      = "additional-node-count"
+		optionNameAdditionalImage          = "additional-bee-image"
+		optionNameAdditionalFullNode       = "additional-full-node"
+		optionNameAdditionalPersistence    = "additional-persistence"
+		optionNameAdditionalStorageClass   = "additional-storage-class"
+		optionNameAdditionalStorageRequest = "additional-storage-request"
+		optionNameImagePullSecrets         = "image-pull-secrets"
+	)
+
+	var (
+		imagePullSecrets         []string
+		startCluster             bool
+		clusterName              string
+		bootnodeCount            int
+		nodeCount                int
+		image                    string
+		persistence              bool
+		storageClass             string
+		storageRequest           string
+		fullNode                 bool
+		additionalNodeCount      int
+		additionalImage          string
+		additionalFullNode       bool
+		additionalPersistence    bool
+		additionalStorageClass   string
+		additionalStorageRequest string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "feeds",
+		Short: "Checks feeds ability of the cluster",
+		Long: `Checks feeds ability of the cluster.
+It publishes given number of sequential feed updates from a random node
+and checks that all other nodes resolve the latest update and its payload.`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			k8sClient, err := setK8SClient(c.config.GetString(optionNameKubeconfig), c.config.GetBool(optionNameInCluster))
+			if err != nil {
+				return fmt.Errorf("creating Kubernetes client: %w", err)
+			}
+
+			k8sBee, err := c.newK8SBee(k8sClient)
+			if err != nil {
+				return fmt.Errorf("creating Kubernetes Bee client: %w", err)
+			}
+
+			namespace := c.config.GetString(optionNameNamespace)
+			cluster := bee.NewCluster(clusterName, bee.ClusterOptions{
+				APIDomain:           c.config.GetString(optionNameAPIDomain),
+				APIInsecureTLS:      insecureTLSAPI,
+				APIScheme:           c.config.GetString(optionNameAPIScheme),
+				DebugAPIDomain:      c.config.GetString(optionNameDebugAPIDomain),
+				DebugAPIInsecureTLS: insecureTLSDebugAPI,
+				DebugAPIScheme:      c.config.GetString(optionNameDebugAPIScheme),
+				K8SClient:           k8sClient,
+				K8SBee:              k8sBee,
+				Namespace:           namespace,
+				DisableNamespace:    disableNamespace,
+				Ledger:              c.ledger,
+			})
+
+			cicd := newCICDOptions(clefSignerEnable, dbCapacity, paymentEarly, paymentThreshold, paymentTolerance, swapEnable, swapEndpoint, swapFactoryAddress, swapInitialDeposit, nodeSelector, ingressClass)
+
+			if startCluster {
+				// bootnodes group
+				bgName := "bootnode"
+				bCtx, bCancel := context.WithTimeout(cmd.Context(), 10*time.Minute)
+				defer bCancel()
+				if err := startBootNodeGroup(bCtx, cluster, bootnodeCount, nodeCount, bgName, namespace, image, storageClass, storageRequest, imagePullSecrets, persistence, cicd); err != nil {
+					return fmt.Errorf("starting bootnode group %s: %w", bgName, err)
+				}
+
+				// node groups
+				ngName := "bee"
+				nCtx, nCancel := context.WithTimeout(cmd.Context(), 10*time.Minute)
+				defer nCancel()
+				if err := startNodeGroup(nCtx, cluster, bootnodeCount, nodeCount, ngName, namespace, image, storageClass, storageRequest, imagePullSecrets, persistence, fullNode, cicd); err != nil {
+					return fmt.Errorf("starting node group %s: %w", ngName, err)
+				}
+
+				if additionalNodeCount > 0 {
+					addNgName := "drone"
+					addNCtx, addNCancel := context.WithTimeout(cmd.Context(), 10*time.Minute)
+					defer addNCancel()
+					if err := startNodeGroup(addNCtx, cluster, bootnodeCount, additionalNodeCount, addNgName, namespace, additionalImage, additionalStorageClass, additionalStorageRequest, imagePullSecrets, additionalPersistence, additionalFullNode, cicd); err != nil {
+						return fmt.Errorf("starting node group %s: %w", addNgName, err)
+					}
+				}
+			} else {
+				// bootnodes group
+				if bootnodeCount > 0 {
+					bgName := "bootnode"
+					if err := addBootNodeGroup(cluster, bootnodeCount, nodeCount, bgName, namespace, image, storageClass, storageRequest, persistence); err != nil {
+						return fmt.Errorf("adding bootnode group %s: %w", bgName, err)
+					}
+				}
+
+				// node groups
+				ngName := "bee"
+				if err := addNodeGroup(cluster, bootnodeCount, nodeCount, ngName, namespace, image, storageClass, storageRequest, persistence); err != nil {
+					return fmt.Errorf("adding node group %s: %w", ngName, err)
+				}
+
+				if additionalNodeCount > 0 {
+					addNgName := "drone"
+					if err := addNodeGroup(cluster, bootnodeCount, additionalNodeCount, addNgName, namespace, additionalImage, additionalStorageClass, additionalStorageRequest, additionalPersistence); err != nil {
+						return fmt.Errorf("starting node group %s: %w", addNgName, err)
+					}
+				}
+			}
+
+			var seed int64
+			if cmd.Flags().Changed("seed") {
+				seed = c.config.GetInt64(optionNameSeed)
+			} else {
+				seed = random.Int64()
+			}
+			c.ledger.SetSeed(seed)
+
+			return c.withChaos(cmd, func() error {
+				return feeds.Check(cluster, feeds.Options{
+					UpdateCount:    c.config.GetInt(optionNameUpdateCount),
+					UpdateSize:     c.config.GetInt(optionNameUpdateSize),
+					Seed:           seed,
+					PostageAmount:  c.config.GetInt64(optionNamePostageAmount),
+					PostageWait:    c.config.GetDuration(optionNamePostageBatchhWait),
+					PostageDepth:   c.config.GetUint64(optionNamePostageDepth),
+					RequestTimeout: c.config.GetDuration(optionNameTimeout),
+					RetryDelay:     c.config.GetDuration(optionNameRetryDelay),
+				})
+			})
+		},
+		PreRunE: c.checkPreRunE,
+	}
+
+	cmd.Flags().Int64P(optionNameSeed, "s", 0, "seed for generating feed updates; if not set, will be random")
+	cmd.Flags().Int(optionNameUpdateCount, 5, "number of feed updates to publish")
+	cmd.Flags().Int(optionNameUpdateSize, 1024, "size of feed update payload in bytes")
+	cmd.Flags().Duration(optionNameTimeout, 5*time.Minute, "timeout duration for resolving the latest feed update on a node")
+	cmd.Flags().Duration(optionNameRetryDelay, 5*time.Second, "delay between feed lookups")
+	cmd.Flags().BoolVar(&startCluster, optionNameStartCluster, false, "start new cluster")
+	cmd.Flags().StringVar(&clusterName, optionNameClusterName, "beekeeper", "cluster name")
+	cmd.Flags().IntVarP(&bootnodeCount, optionNameBootnodeCount, "b", 0, "number of bootnodes")
+	cmd.Flags().IntVarP(&nodeCount, optionNameNodeCount, "c", 1, "number of nodes")
+	cmd.Flags().StringVar(&image, optionNameImage, "ethersphere/bee:latest", "Bee Docker image")
+	cmd.PersistentFlags().BoolVar(&persistence, optionNamePersistence, false, "use persistent storage")
+	cmd.PersistentFlags().StringVar(&storageClass, optionNameStorageClass, "local-storage", "storage class name")
+	cmd.PersistentFlags().StringVar(&storageRequest, optionNameStorageRequest, "34Gi", "storage request")
+	cmd.PersistentFlags().BoolVar(&fullNode, optionNameFullNode, true, "start node in full mode")
+	cmd.Flags().IntVar(&additionalNodeCount, optionNameAdditionalNodeCount, 0, "number of nodes in additional node group")
+	cmd.Flags().StringVar(&additionalImage, optionNameAdditionalImage, "ethersphere/bee:latest", "Bee Docker image in additional node group")
+	cmd.PersistentFlags().BoolVar(&additionalFullNode, optionNameAdditionalFullNode, false, "start node in full mode")
+	cmd.PersistentFlags().BoolVar(&additionalPersistence, optionNameAdditionalPersistence, false, "use persistent storage")
+	cmd.PersistentFlags().StringVar(&additionalStorageClass, optionNameAdditionalStorageClass, "local-storage", "storage class name")
+	cmd.PersistentFlags().StringVar(&additionalStorageRequest, optionNameAdditionalStorageRequest, "34Gi", "storage request")
+	cmd.Flags().StringArrayVar(&imagePullSecrets, optionNameImagePullSecrets, []string{"regcred"}, "image pull secrets")
+
+	return cmd
+}
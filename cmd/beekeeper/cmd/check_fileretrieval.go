@@ -39,6 +39,7 @@ func (c *command) initCheckFileRetrieval() *cobra.Command {
 		optionNameFilesInCollection        = "files-in-collection"
 		optionMaxPathnameLength            = "maximum-pathname-length"
 		optionNameImagePullSecrets         = "image-pull-secrets"
+		optionNameEncrypt                  = "encrypt"
 	)
 
 	var (
@@ -77,6 +78,11 @@ and attempts retrieval of those files from the last node in the cluster.`,
 				return fmt.Errorf("creating Kubernetes client: %w", err)
 			}
 
+			k8sBee, err := c.newK8SBee(k8sClient)
+			if err != nil {
+				return fmt.Errorf("creating Kubernetes Bee client: %w", err)
+			}
+
 			namespace := c.config.GetString(optionNameNamespace)
 			cluster := bee.NewCluster(clusterName, bee.ClusterOptions{
 				APIDomain:           c.config.GetString(optionNameAPIDomain),
@@ -86,8 +92,10 @@ and attempts retrieval of those files from the last node in the cluster.`,
 				DebugAPIInsecureTLS: insecureTLSDebugAPI,
 				DebugAPIScheme:      c.config.GetString(optionNameDebugAPIScheme),
 				K8SClient:           k8sClient,
+				K8SBee:              k8sBee,
 				Namespace:           namespace,
 				DisableNamespace:    disableNamespace,
+				Ledger:              c.ledger,
 			})
 
 			cicd := newCICDOptions(clefSignerEnable, dbCapacity, paymentEarly, paymentThreshold, paymentTolerance, swapEnable, swapEndpoint, swapFactoryAddress, swapInitialDeposit, nodeSelector, ingressClass)
@@ -148,11 +156,27 @@ and attempts retrieval of those files from the last node in the cluster.`,
 			} else {
 				seed = random.Int64()
 			}
+			c.ledger.SetSeed(seed)
 
 			fileSize := round(c.config.GetFloat64(optionNameFileSize) * 1024 * 1024)
 
 			if full {
-				return fileretrieval.CheckFull(cluster, fileretrieval.Options{
+				return c.withChaos(cmd, func() error {
+					return fileretrieval.CheckFull(cluster, fileretrieval.Options{
+						UploadNodeCount: c.config.GetInt(optionNameUploadNodeCount),
+						FilesPerNode:    c.config.GetInt(optionNameFilesPerNode),
+						FileName:        c.config.GetString(optionNameFileName),
+						FileSize:        fileSize,
+						Seed:            seed,
+						PostageAmount:   c.config.GetInt64(optionNamePostageAmount),
+						PostageWait:     c.config.GetDuration(optionNamePostageBatchhWait),
+						Encrypt:         c.config.GetBool(optionNameEncrypt),
+					}, pusher, c.config.GetBool(optionNamePushMetrics))
+				})
+			}
+
+			return c.withChaos(cmd, func() error {
+				return fileretrieval.Check(cluster, fileretrieval.Options{
 					UploadNodeCount: c.config.GetInt(optionNameUploadNodeCount),
 					FilesPerNode:    c.config.GetInt(optionNameFilesPerNode),
 					FileName:        c.config.GetString(optionNameFileName),
@@ -160,18 +184,9 @@ and attempts retrieval of those files from the last node in the cluster.`,
 					Seed:            seed,
 					PostageAmount:   c.config.GetInt64(optionNamePostageAmount),
 					PostageWait:     c.config.GetDuration(optionNamePostageBatchhWait),
+					Encrypt:         c.config.GetBool(optionNameEncrypt),
 				}, pusher, c.config.GetBool(optionNamePushMetrics))
-			}
-
-			return fileretrieval.Check(cluster, fileretrieval.Options{
-				UploadNodeCount: c.config.GetInt(optionNameUploadNodeCount),
-				FilesPerNode:    c.config.GetInt(optionNameFilesPerNode),
-				FileName:        c.config.GetString(optionNameFileName),
-				FileSize:        fileSize,
-				Seed:            seed,
-				PostageAmount:   c.config.GetInt64(optionNamePostageAmount),
-				PostageWait:     c.config.GetDuration(optionNamePostageBatchhWait),
-			}, pusher, c.config.GetBool(optionNamePushMetrics))
+			})
 		},
 		PreRunE: c.checkPreRunE,
 	}
@@ -200,6 +215,7 @@ and attempts retrieval of those files from the last node in the cluster.`,
 	cmd.Flags().Int(optionNameFilesInCollection, 10, "number of files to upload in single collection")
 	cmd.Flags().Int32(optionMaxPathnameLength, 64, "maximum pathname length for files")
 	cmd.Flags().StringArrayVar(&imagePullSecrets, optionNameImagePullSecrets, []string{"regcred"}, "image pull secrets")
+	cmd.Flags().Bool(optionNameEncrypt, false, "upload files encrypted")
 
 	return cmd
 }
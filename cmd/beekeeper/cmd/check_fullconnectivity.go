@@ -61,6 +61,11 @@ func (c *command) initCheckFullConnectivity() *cobra.Command {
 				return fmt.Errorf("creating Kubernetes client: %w", err)
 			}
 
+			k8sBee, err := c.newK8SBee(k8sClient)
+			if err != nil {
+				return fmt.Errorf("creating Kubernetes Bee client: %w", err)
+			}
+
 			namespace := c.config.GetString(optionNameNamespace)
 			cluster := bee.NewCluster(clusterName, bee.ClusterOptions{
 				APIDomain:           c.config.GetString(optionNameAPIDomain),
@@ -70,8 +75,10 @@ func (c *command) initCheckFullConnectivity() *cobra.Command {
 				DebugAPIInsecureTLS: insecureTLSDebugAPI,
 				DebugAPIScheme:      c.config.GetString(optionNameDebugAPIScheme),
 				K8SClient:           k8sClient,
+				K8SBee:              k8sBee,
 				Namespace:           namespace,
 				DisableNamespace:    disableNamespace,
+				Ledger:              c.ledger,
 			})
 
 			cicd := newCICDOptions(clefSignerEnable, dbCapacity, paymentEarly, paymentThreshold, paymentTolerance, swapEnable, swapEndpoint, swapFactoryAddress, swapInitialDeposit, nodeSelector, ingressClass)
@@ -124,7 +131,9 @@ func (c *command) initCheckFullConnectivity() *cobra.Command {
 				}
 			}
 
-			return fullconnectivity.Check(cmd.Context(), cluster)
+			return c.withChaos(cmd, func() error {
+				return fullconnectivity.Check(cmd.Context(), cluster)
+			})
 		},
 		PreRunE: c.checkPreRunE,
 	}
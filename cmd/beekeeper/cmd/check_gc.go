@@ -67,6 +67,11 @@ func (c *command) initCheckGc() *cobra.Command {
 				return fmt.Errorf("creating Kubernetes client: %w", err)
 			}
 
+			k8sBee, err := c.newK8SBee(k8sClient)
+			if err != nil {
+				return fmt.Errorf("creating Kubernetes Bee client: %w", err)
+			}
+
 			namespace := c.config.GetString(optionNameNamespace)
 			cluster := bee.NewCluster(clusterName, bee.ClusterOptions{
 				APIDomain:           c.config.GetString(optionNameAPIDomain),
@@ -76,8 +81,10 @@ func (c *command) initCheckGc() *cobra.Command {
 				DebugAPIInsecureTLS: insecureTLSDebugAPI,
 				DebugAPIScheme:      c.config.GetString(optionNameDebugAPIScheme),
 				K8SClient:           k8sClient,
+				K8SBee:              k8sBee,
 				Namespace:           namespace,
 				DisableNamespace:    disableNamespace,
+				Ledger:              c.ledger,
 			})
 
 			cicd := newCICDOptions(clefSignerEnable, dbCapacity, paymentEarly, paymentThreshold, paymentTolerance, swapEnable, swapEndpoint, swapFactoryAddress, swapInitialDeposit, nodeSelector, ingressClass)
@@ -136,13 +143,16 @@ func (c *command) initCheckGc() *cobra.Command {
 			} else {
 				seed = random.Int64()
 			}
-
-			return gc.CheckReserve(cluster, gc.Options{
-				CacheSize:     c.config.GetInt(optionNameCacheCapacity),
-				Seed:          seed,
-				PostageAmount: c.config.GetInt64(optionNamePostageAmount),
-				PostageWait:   c.config.GetDuration(optionNamePostageBatchhWait),
-				ReserveSize:   c.config.GetInt(optionReserveSize),
+			c.ledger.SetSeed(seed)
+
+			return c.withChaos(cmd, func() error {
+				return gc.CheckReserve(cluster, gc.Options{
+					CacheSize:     c.config.GetInt(optionNameCacheCapacity),
+					Seed:          seed,
+					PostageAmount: c.config.GetInt64(optionNamePostageAmount),
+					PostageWait:   c.config.GetDuration(optionNamePostageBatchhWait),
+					ReserveSize:   c.config.GetInt(optionReserveSize),
+				})
 			})
 
 		},
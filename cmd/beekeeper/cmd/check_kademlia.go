@@ -66,6 +66,11 @@ func (c *command) initCheckKademlia() *cobra.Command {
 				return fmt.Errorf("creating Kubernetes client: %w", err)
 			}
 
+			k8sBee, err := c.newK8SBee(k8sClient)
+			if err != nil {
+				return fmt.Errorf("creating Kubernetes Bee client: %w", err)
+			}
+
 			namespace := c.config.GetString(optionNameNamespace)
 			cluster := bee.NewCluster(clusterName, bee.ClusterOptions{
 				APIDomain:           c.config.GetString(optionNameAPIDomain),
@@ -75,8 +80,10 @@ func (c *command) initCheckKademlia() *cobra.Command {
 				DebugAPIInsecureTLS: insecureTLSDebugAPI,
 				DebugAPIScheme:      c.config.GetString(optionNameDebugAPIScheme),
 				K8SClient:           k8sClient,
+				K8SBee:              k8sBee,
 				Namespace:           namespace,
 				DisableNamespace:    disableNamespace,
+				Ledger:              c.ledger,
 			})
 
 			cicd := newCICDOptions(clefSignerEnable, dbCapacity, paymentEarly, paymentThreshold, paymentTolerance, swapEnable, swapEndpoint, swapFactoryAddress, swapInitialDeposit, nodeSelector, ingressClass)
@@ -135,6 +142,7 @@ func (c *command) initCheckKademlia() *cobra.Command {
 			} else {
 				seed = random.Int64()
 			}
+			c.ledger.SetSeed(seed)
 
 			if dynamic {
 				if len(dynamicActions)%4 != 0 {
@@ -151,13 +159,17 @@ func (c *command) initCheckKademlia() *cobra.Command {
 					})
 				}
 
-				return kademlia.CheckDynamic(cmd.Context(), cluster, kademlia.Options{
-					Seed:           seed,
-					DynamicActions: kActions,
+				return c.withChaos(cmd, func() error {
+					return kademlia.CheckDynamic(cmd.Context(), cluster, kademlia.Options{
+						Seed:           seed,
+						DynamicActions: kActions,
+					})
 				})
 			}
 
-			return kademlia.Check(cmd.Context(), cluster)
+			return c.withChaos(cmd, func() error {
+				return kademlia.Check(cmd.Context(), cluster)
+			})
 		},
 		PreRunE: c.checkPreRunE,
 	}
@@ -32,6 +32,7 @@ func (c *command) initCheckManifest() *cobra.Command {
 		optionNameSeed                     = "seed"
 		optionNameFilesInCollection        = "files-in-collection"
 		optionMaxPathnameLength            = "maximum-pathname-length"
+		optionNameEncrypt                  = "encrypt"
 	)
 
 	var (
@@ -65,6 +66,11 @@ and attempts retrieval of those files from the last node in the cluster.`,
 				return fmt.Errorf("creating Kubernetes client: %w", err)
 			}
 
+			k8sBee, err := c.newK8SBee(k8sClient)
+			if err != nil {
+				return fmt.Errorf("creating Kubernetes Bee client: %w", err)
+			}
+
 			namespace := c.config.GetString(optionNameNamespace)
 			cluster := bee.NewCluster(clusterName, bee.ClusterOptions{
 				APIDomain:           c.config.GetString(optionNameAPIDomain),
@@ -74,8 +80,10 @@ and attempts retrieval of those files from the last node in the cluster.`,
 				DebugAPIInsecureTLS: insecureTLSDebugAPI,
 				DebugAPIScheme:      c.config.GetString(optionNameDebugAPIScheme),
 				K8SClient:           k8sClient,
+				K8SBee:              k8sBee,
 				Namespace:           namespace,
 				DisableNamespace:    disableNamespace,
+				Ledger:              c.ledger,
 			})
 
 			cicd := newCICDOptions(clefSignerEnable, dbCapacity, paymentEarly, paymentThreshold, paymentTolerance, swapEnable, swapEndpoint, swapFactoryAddress, swapInitialDeposit, nodeSelector, ingressClass)
@@ -134,14 +142,18 @@ and attempts retrieval of those files from the last node in the cluster.`,
 			} else {
 				seed = random.Int64()
 			}
-
-			return manifest.Check(cluster, manifest.Options{
-				FilesInCollection: c.config.GetInt(optionNameFilesInCollection),
-				MaxPathnameLength: c.config.GetInt32(optionMaxPathnameLength),
-				Seed:              seed,
-				PostageAmount:     c.config.GetInt64(optionNamePostageAmount),
-				PostageWait:       c.config.GetDuration(optionNamePostageBatchhWait),
-				PostageDepth:      c.config.GetUint64(optionNamePostageDepth),
+			c.ledger.SetSeed(seed)
+
+			return c.withChaos(cmd, func() error {
+				return manifest.Check(cluster, manifest.Options{
+					FilesInCollection: c.config.GetInt(optionNameFilesInCollection),
+					MaxPathnameLength: c.config.GetInt32(optionMaxPathnameLength),
+					Seed:              seed,
+					PostageAmount:     c.config.GetInt64(optionNamePostageAmount),
+					PostageWait:       c.config.GetDuration(optionNamePostageBatchhWait),
+					PostageDepth:      c.config.GetUint64(optionNamePostageDepth),
+					Encrypt:           c.config.GetBool(optionNameEncrypt),
+				})
 			})
 
 		},
@@ -167,6 +179,7 @@ and attempts retrieval of those files from the last node in the cluster.`,
 	cmd.Flags().Int64P(optionNameSeed, "s", 0, "seed for generating files; if not set, will be random")
 	cmd.Flags().Int(optionNameFilesInCollection, 10, "number of files to upload in single collection")
 	cmd.Flags().Int32(optionMaxPathnameLength, 64, "maximum pathname length for files")
+	cmd.Flags().Bool(optionNameEncrypt, false, "upload collection encrypted")
 
 	return cmd
 }
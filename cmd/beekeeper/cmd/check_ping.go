@@ -66,6 +66,11 @@ and prints round-trip time (RTT) of each ping.`,
 				return fmt.Errorf("creating Kubernetes client: %w", err)
 			}
 
+			k8sBee, err := c.newK8SBee(k8sClient)
+			if err != nil {
+				return fmt.Errorf("creating Kubernetes Bee client: %w", err)
+			}
+
 			namespace := c.config.GetString(optionNameNamespace)
 			cluster := bee.NewCluster(clusterName, bee.ClusterOptions{
 				APIDomain:           c.config.GetString(optionNameAPIDomain),
@@ -75,8 +80,10 @@ and prints round-trip time (RTT) of each ping.`,
 				DebugAPIInsecureTLS: insecureTLSDebugAPI,
 				DebugAPIScheme:      c.config.GetString(optionNameDebugAPIScheme),
 				K8SClient:           k8sClient,
+				K8SBee:              k8sBee,
 				Namespace:           namespace,
 				DisableNamespace:    disableNamespace,
+				Ledger:              c.ledger,
 			})
 
 			cicd := newCICDOptions(clefSignerEnable, dbCapacity, paymentEarly, paymentThreshold, paymentTolerance, swapEnable, swapEndpoint, swapFactoryAddress, swapInitialDeposit, nodeSelector, ingressClass)
@@ -135,6 +142,7 @@ and prints round-trip time (RTT) of each ping.`,
 			} else {
 				seed = random.Int64()
 			}
+			c.ledger.SetSeed(seed)
 			buffer := 12
 
 			checkCtx, checkCancel := context.WithTimeout(cmd.Context(), 15*time.Minute)
@@ -146,7 +154,9 @@ and prints round-trip time (RTT) of each ping.`,
 				MetricsPusher:  push.New(c.config.GetString(optionNamePushGateway), namespace),
 			}
 
-			return check.RunConcurrently(checkCtx, cluster, checkPing, checkOptions, []check.Stage{}, buffer, seed)
+			return c.withChaos(cmd, func() error {
+				return check.RunConcurrently(checkCtx, cluster, checkPing, checkOptions, []check.Stage{}, buffer, seed)
+			})
 		},
 		PreRunE: c.checkPreRunE,
 	}
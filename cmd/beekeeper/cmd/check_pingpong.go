@@ -65,6 +65,11 @@ and prints round-trip time (RTT) of each ping.`,
 				return fmt.Errorf("creating Kubernetes client: %w", err)
 			}
 
+			k8sBee, err := c.newK8SBee(k8sClient)
+			if err != nil {
+				return fmt.Errorf("creating Kubernetes Bee client: %w", err)
+			}
+
 			namespace := c.config.GetString(optionNameNamespace)
 			cluster := bee.NewCluster(clusterName, bee.ClusterOptions{
 				APIDomain:           c.config.GetString(optionNameAPIDomain),
@@ -74,8 +79,10 @@ and prints round-trip time (RTT) of each ping.`,
 				DebugAPIInsecureTLS: insecureTLSDebugAPI,
 				DebugAPIScheme:      c.config.GetString(optionNameDebugAPIScheme),
 				K8SClient:           k8sClient,
+				K8SBee:              k8sBee,
 				Namespace:           namespace,
 				DisableNamespace:    disableNamespace,
+				Ledger:              c.ledger,
 			})
 
 			cicd := newCICDOptions(clefSignerEnable, dbCapacity, paymentEarly, paymentThreshold, paymentTolerance, swapEnable, swapEndpoint, swapFactoryAddress, swapInitialDeposit, nodeSelector, ingressClass)
@@ -131,9 +138,11 @@ and prints round-trip time (RTT) of each ping.`,
 			checkCtx, checkCancel := context.WithTimeout(cmd.Context(), 15*time.Minute)
 			defer checkCancel()
 
-			return pingpong.Check(checkCtx, cluster, pingpong.Options{
-				MetricsEnabled: c.config.GetBool(optionNamePushMetrics),
-				MetricsPusher:  push.New(c.config.GetString(optionNamePushGateway), namespace),
+			return c.withChaos(cmd, func() error {
+				return pingpong.Check(checkCtx, cluster, pingpong.Options{
+					MetricsEnabled: c.config.GetBool(optionNamePushMetrics),
+					MetricsPusher:  push.New(c.config.GetString(optionNamePushGateway), namespace),
+				})
 			})
 		},
 		PreRunE: c.checkPreRunE,
@@ -0,0 +1,192 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ethersphere/beekeeper/pkg/bee"
+	"github.com/ethersphere/beekeeper/pkg/check/pinning"
+	"github.com/ethersphere/beekeeper/pkg/random"
+
+	"github.com/spf13/cobra"
+)
+
+func (c *command) initCheckPinning() *cobra.Command {
+	const (
+		optionNameSeed                     = "seed"
+		optionNameChunkCount               = "chunk-count"
+		optionNameFileSize                 = "file-size"
+		optionNameFilesInCollection        = "files-in-collection"
+		optionNameGCWait                   = "gc-wait"
+		optionNameGCRetries                = "gc-retries"
+		optionNameStartCluster             = "start-cluster"
+		optionNameClusterName              = "cluster-name"
+		optionNameBootnodeCount            = "bootnode-count"
+		optionNameNodeCount                = "node-count"
+		optionNameImage                    = "bee-image"
+		optionNamePersistence              = "persistence"
+		optionNameStorageClass             = "storage-class"
+		optionNameStorageRequest           = "storage-request"
+		optionNameFullNode                 = "full-node"
+		optionNameAdditionalNodeCount      = "additional-node-count"
+		optionNameAdditionalImage          = "additional-bee-image"
+		optionNameAdditionalFullNode       = "additional-full-node"
+		optionNameAdditionalPersistence    = "additional-persistence"
+		optionNameAdditionalStorageClass   = "additional-storage-class"
+		optionNameAdditionalStorageRequest = "additional-storage-request"
+		optionNameImagePullSecrets         = "image-pull-secrets"
+	)
+
+	var (
+		imagePullSecrets         []string
+		startCluster             bool
+		clusterName              string
+		bootnodeCount            int
+		nodeCount                int
+		image                    string
+		persistence              bool
+		storageClass             string
+		storageRequest           string
+		fullNode                 bool
+		additionalNodeCount      int
+		additionalImage          string
+		additionalFullNode       bool
+		additionalPersistence    bool
+		additionalStorageClass   string
+		additionalStorageRequest string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "pinning",
+		Short: "Checks pinning lifecycle on a node",
+		Long: `Checks pinning lifecycle on a node.
+It uploads files, a collection and chunks to a random node, pins them on upload
+and after the fact, unpins some of them and floods node's cache to force garbage collection.
+Pinned content must survive garbage collection while unpinned content must be collected.`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			k8sClient, err := setK8SClient(c.config.GetString(optionNameKubeconfig), c.config.GetBool(optionNameInCluster))
+			if err != nil {
+				return fmt.Errorf("creating Kubernetes client: %w", err)
+			}
+
+			k8sBee, err := c.newK8SBee(k8sClient)
+			if err != nil {
+				return fmt.Errorf("creating Kubernetes Bee client: %w", err)
+			}
+
+			namespace := c.config.GetString(optionNameNamespace)
+			cluster := bee.NewCluster(clusterName, bee.ClusterOptions{
+				APIDomain:           c.config.GetString(optionNameAPIDomain),
+				APIInsecureTLS:      insecureTLSAPI,
+				APIScheme:           c.config.GetString(optionNameAPIScheme),
+				DebugAPIDomain:      c.config.GetString(optionNameDebugAPIDomain),
+				DebugAPIInsecureTLS: insecureTLSDebugAPI,
+				DebugAPIScheme:      c.config.GetString(optionNameDebugAPIScheme),
+				K8SClient:           k8sClient,
+				K8SBee:              k8sBee,
+				Namespace:           namespace,
+				DisableNamespace:    disableNamespace,
+				Ledger:              c.ledger,
+			})
+
+			cicd := newCICDOptions(clefSignerEnable, dbCapacity, paymentEarly, paymentThreshold, paymentTolerance, swapEnable, swapEndpoint, swapFactoryAddress, swapInitialDeposit, nodeSelector, ingressClass)
+
+			if startCluster {
+				// bootnodes group
+				bgName := "bootnode"
+				bCtx, bCancel := context.WithTimeout(cmd.Context(), 10*time.Minute)
+				defer bCancel()
+				if err := startBootNodeGroup(bCtx, cluster, bootnodeCount, nodeCount, bgName, namespace, image, storageClass, storageRequest, imagePullSecrets, persistence, cicd); err != nil {
+					return fmt.Errorf("starting bootnode group %s: %w", bgName, err)
+				}
+
+				// node groups
+				ngName := "bee"
+				nCtx, nCancel := context.WithTimeout(cmd.Context(), 10*time.Minute)
+				defer nCancel()
+				if err := startNodeGroup(nCtx, cluster, bootnodeCount, nodeCount, ngName, namespace, image, storageClass, storageRequest, imagePullSecrets, persistence, fullNode, cicd); err != nil {
+					return fmt.Errorf("starting node group %s: %w", ngName, err)
+				}
+
+				if additionalNodeCount > 0 {
+					addNgName := "drone"
+					addNCtx, addNCancel := context.WithTimeout(cmd.Context(), 10*time.Minute)
+					defer addNCancel()
+					if err := startNodeGroup(addNCtx, cluster, bootnodeCount, additionalNodeCount, addNgName, namespace, additionalImage, additionalStorageClass, additionalStorageRequest, imagePullSecrets, additionalPersistence, additionalFullNode, cicd); err != nil {
+						return fmt.Errorf("starting node group %s: %w", addNgName, err)
+					}
+				}
+			} else {
+				// bootnodes group
+				if bootnodeCount > 0 {
+					bgName := "bootnode"
+					if err := addBootNodeGroup(cluster, bootnodeCount, nodeCount, bgName, namespace, image, storageClass, storageRequest, persistence); err != nil {
+						return fmt.Errorf("adding bootnode group %s: %w", bgName, err)
+					}
+				}
+
+				// node groups
+				ngName := "bee"
+				if err := addNodeGroup(cluster, bootnodeCount, nodeCount, ngName, namespace, image, storageClass, storageRequest, persistence); err != nil {
+					return fmt.Errorf("adding node group %s: %w", ngName, err)
+				}
+
+				if additionalNodeCount > 0 {
+					addNgName := "drone"
+					if err := addNodeGroup(cluster, bootnodeCount, additionalNodeCount, addNgName, namespace, additionalImage, additionalStorageClass, additionalStorageRequest, additionalPersistence); err != nil {
+						return fmt.Errorf("starting node group %s: %w", addNgName, err)
+					}
+				}
+			}
+
+			var seed int64
+			if cmd.Flags().Changed("seed") {
+				seed = c.config.GetInt64(optionNameSeed)
+			} else {
+				seed = random.Int64()
+			}
+			c.ledger.SetSeed(seed)
+
+			return c.withChaos(cmd, func() error {
+				return pinning.Check(cluster, pinning.Options{
+					CacheSize:         c.config.GetInt(optionNameCacheCapacity),
+					ChunkCount:        c.config.GetInt(optionNameChunkCount),
+					FileSize:          round(c.config.GetFloat64(optionNameFileSize) * 1024 * 1024),
+					FilesInCollection: c.config.GetInt(optionNameFilesInCollection),
+					Seed:              seed,
+					PostageAmount:     c.config.GetInt64(optionNamePostageAmount),
+					PostageWait:       c.config.GetDuration(optionNamePostageBatchhWait),
+					GCWait:            c.config.GetDuration(optionNameGCWait),
+					GCRetries:         c.config.GetInt(optionNameGCRetries),
+				})
+			})
+		},
+		PreRunE: c.checkPreRunE,
+	}
+
+	cmd.Flags().Int64P(optionNameSeed, "s", 0, "seed for generating files and chunks; if not set, will be random")
+	cmd.Flags().Int(optionNameChunkCount, 10, "number of pinned and unpinned chunks to upload")
+	cmd.Flags().Float64(optionNameFileSize, 1, "file size in MB")
+	cmd.Flags().Int(optionNameFilesInCollection, 10, "number of files to upload in single collection")
+	cmd.Flags().Duration(optionNameGCWait, 5*time.Second, "wait duration between garbage collection checks")
+	cmd.Flags().Int(optionNameGCRetries, 5, "number of garbage collection checks")
+	cmd.Flags().BoolVar(&startCluster, optionNameStartCluster, false, "start new cluster")
+	cmd.Flags().StringVar(&clusterName, optionNameClusterName, "beekeeper", "cluster name")
+	cmd.Flags().IntVarP(&bootnodeCount, optionNameBootnodeCount, "b", 0, "number of bootnodes")
+	cmd.Flags().IntVarP(&nodeCount, optionNameNodeCount, "c", 1, "number of nodes")
+	cmd.Flags().StringVar(&image, optionNameImage, "ethersphere/bee:latest", "Bee Docker image")
+	cmd.PersistentFlags().BoolVar(&persistence, optionNamePersistence, false, "use persistent storage")
+	cmd.PersistentFlags().StringVar(&storageClass, optionNameStorageClass, "local-storage", "storage class name")
+	cmd.PersistentFlags().StringVar(&storageRequest, optionNameStorageRequest, "34Gi", "storage request")
+	cmd.PersistentFlags().BoolVar(&fullNode, optionNameFullNode, true, "start node in full mode")
+	cmd.Flags().IntVar(&additionalNodeCount, optionNameAdditionalNodeCount, 0, "number of nodes in additional node group")
+	cmd.Flags().StringVar(&additionalImage, optionNameAdditionalImage, "ethersphere/bee:latest", "Bee Docker image in additional node group")
+	cmd.PersistentFlags().BoolVar(&additionalFullNode, optionNameAdditionalFullNode, false, "start node in full mode")
+	cmd.PersistentFlags().BoolVar(&additionalPersistence, optionNameAdditionalPersistence, false, "use persistent storage")
+	cmd.PersistentFlags().StringVar(&additionalStorageClass, optionNameAdditionalStorageClass, "local-storage", "storage class name")
+	cmd.PersistentFlags().StringVar(&additionalStorageRequest, optionNameAdditionalStorageRequest, "34Gi", "storage request")
+	cmd.Flags().StringArrayVar(&imagePullSecrets, optionNameImagePullSecrets, []string{"regcred"}, "image pull secrets")
+
+	return cmd
+}
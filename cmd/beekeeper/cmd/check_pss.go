@@ -67,6 +67,11 @@ sends PSS messages to random nodes to check if WebSocket connections receive the
 				return fmt.Errorf("creating Kubernetes client: %w", err)
 			}
 
+			k8sBee, err := c.newK8SBee(k8sClient)
+			if err != nil {
+				return fmt.Errorf("creating Kubernetes Bee client: %w", err)
+			}
+
 			namespace := c.config.GetString(optionNameNamespace)
 			cluster := bee.NewCluster(clusterName, bee.ClusterOptions{
 				APIDomain:           c.config.GetString(optionNameAPIDomain),
@@ -76,8 +81,10 @@ sends PSS messages to random nodes to check if WebSocket connections receive the
 				DebugAPIInsecureTLS: insecureTLSDebugAPI,
 				DebugAPIScheme:      c.config.GetString(optionNameDebugAPIScheme),
 				K8SClient:           k8sClient,
+				K8SBee:              k8sBee,
 				Namespace:           namespace,
 				DisableNamespace:    disableNamespace,
+				Ledger:              c.ledger,
 			})
 
 			cicd := newCICDOptions(clefSignerEnable, dbCapacity, paymentEarly, paymentThreshold, paymentTolerance, swapEnable, swapEndpoint, swapFactoryAddress, swapInitialDeposit, nodeSelector, ingressClass)
@@ -136,18 +143,21 @@ sends PSS messages to random nodes to check if WebSocket connections receive the
 			} else {
 				seed = random.Int64()
 			}
+			c.ledger.SetSeed(seed)
 
 			pusher := push.New(c.config.GetString(optionNamePushGateway), namespace)
 
-			return pss.Check(cluster, pss.Options{
-				NodeCount:      c.config.GetInt(optionNameNodeCount),
-				Seed:           seed,
-				RequestTimeout: c.config.GetDuration(optionTimeout),
-				AddressPrefix:  c.config.GetInt(optionAddrPrefix),
-				PostageAmount:  c.config.GetInt64(optionNamePostageAmount),
-				PostageWait:    c.config.GetDuration(optionNamePostageBatchhWait),
-				PostageDepth:   c.config.GetUint64(optionNamePostageDepth),
-			}, pusher, c.config.GetBool(optionNamePushMetrics))
+			return c.withChaos(cmd, func() error {
+				return pss.Check(cluster, pss.Options{
+					NodeCount:      c.config.GetInt(optionNameNodeCount),
+					Seed:           seed,
+					RequestTimeout: c.config.GetDuration(optionTimeout),
+					AddressPrefix:  c.config.GetInt(optionAddrPrefix),
+					PostageAmount:  c.config.GetInt64(optionNamePostageAmount),
+					PostageWait:    c.config.GetDuration(optionNamePostageBatchhWait),
+					PostageDepth:   c.config.GetUint64(optionNamePostageDepth),
+				}, pusher, c.config.GetBool(optionNamePushMetrics))
+			})
 		},
 		PreRunE: c.checkPreRunE,
 	}
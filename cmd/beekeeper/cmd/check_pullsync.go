@@ -70,6 +70,11 @@ func (c *command) initCheckPullSync() *cobra.Command {
 				return fmt.Errorf("creating Kubernetes client: %w", err)
 			}
 
+			k8sBee, err := c.newK8SBee(k8sClient)
+			if err != nil {
+				return fmt.Errorf("creating Kubernetes Bee client: %w", err)
+			}
+
 			namespace := c.config.GetString(optionNameNamespace)
 			cluster := bee.NewCluster(clusterName, bee.ClusterOptions{
 				APIDomain:           c.config.GetString(optionNameAPIDomain),
@@ -79,8 +84,10 @@ func (c *command) initCheckPullSync() *cobra.Command {
 				DebugAPIInsecureTLS: insecureTLSDebugAPI,
 				DebugAPIScheme:      c.config.GetString(optionNameDebugAPIScheme),
 				K8SClient:           k8sClient,
+				K8SBee:              k8sBee,
 				Namespace:           namespace,
 				DisableNamespace:    disableNamespace,
+				Ledger:              c.ledger,
 			})
 
 			cicd := newCICDOptions(clefSignerEnable, dbCapacity, paymentEarly, paymentThreshold, paymentTolerance, swapEnable, swapEndpoint, swapFactoryAddress, swapInitialDeposit, nodeSelector, ingressClass)
@@ -139,14 +146,24 @@ func (c *command) initCheckPullSync() *cobra.Command {
 			} else {
 				seed = random.Int64()
 			}
+			c.ledger.SetSeed(seed)
+
+			distribution, err := bee.ParseDistribution(c.config.GetString(optionNameDistribution))
+			if err != nil {
+				return err
+			}
 
-			return pullsync.Check(cluster, pullsync.Options{
-				UploadNodeCount:            c.config.GetInt(optionNameUploadNodeCount),
-				ReplicationFactorThreshold: c.config.GetInt(optionNameReplicationFactor),
-				ChunksPerNode:              c.config.GetInt(optionNameChunksPerNode),
-				Seed:                       seed,
-				PostageAmount:              c.config.GetInt64(optionNamePostageAmount),
-				PostageWait:                c.config.GetDuration(optionNamePostageBatchhWait),
+			return c.withChaos(cmd, func() error {
+				return pullsync.Check(cluster, pullsync.Options{
+					UploadNodeCount:            c.config.GetInt(optionNameUploadNodeCount),
+					ReplicationFactorThreshold: c.config.GetInt(optionNameReplicationFactor),
+					ChunksPerNode:              c.config.GetInt(optionNameChunksPerNode),
+					Seed:                       seed,
+					PostageAmount:              c.config.GetInt64(optionNamePostageAmount),
+					PostageWait:                c.config.GetDuration(optionNamePostageBatchhWait),
+					Distribution:               distribution,
+					DistributionDepth:          uint8(c.config.GetUint(optionNameDistributionDepth)),
+				})
 			})
 		},
 		PreRunE: c.checkPreRunE,
@@ -156,6 +173,8 @@ func (c *command) initCheckPullSync() *cobra.Command {
 	cmd.Flags().IntP(optionNameReplicationFactor, "r", 2, "minimal replication factor per chunk")
 	cmd.Flags().IntP(optionNameChunksPerNode, "p", 1, "number of chunks to upload per node")
 	cmd.Flags().Int64P(optionNameSeed, "s", 0, "seed for generating chunks; if not set, will be random")
+	cmd.Flags().String(optionNameDistribution, bee.DistributionUniform.String(), "distribution of chunk addresses relative to node overlays [uniform|neighbourhood|bins|zipf|targets|proximity]")
+	cmd.Flags().Uint8(optionNameDistributionDepth, 0, "neighbourhood depth or bin used by the chunk distribution")
 	cmd.Flags().BoolVar(&startCluster, optionNameStartCluster, false, "start new cluster")
 	cmd.Flags().StringVar(&clusterName, optionNameClusterName, "beekeeper", "cluster name")
 	cmd.Flags().IntVarP(&bootnodeCount, optionNameBootnodeCount, "b", 0, "number of bootnodes")
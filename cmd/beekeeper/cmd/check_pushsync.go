@@ -83,6 +83,11 @@ and checks if chunks are synced to their closest nodes.`,
 				return fmt.Errorf("creating Kubernetes client: %w", err)
 			}
 
+			k8sBee, err := c.newK8SBee(k8sClient)
+			if err != nil {
+				return fmt.Errorf("creating Kubernetes Bee client: %w", err)
+			}
+
 			namespace := c.config.GetString(optionNameNamespace)
 			cluster := bee.NewCluster(clusterName, bee.ClusterOptions{
 				APIDomain:           c.config.GetString(optionNameAPIDomain),
@@ -92,8 +97,10 @@ and checks if chunks are synced to their closest nodes.`,
 				DebugAPIInsecureTLS: insecureTLSDebugAPI,
 				DebugAPIScheme:      c.config.GetString(optionNameDebugAPIScheme),
 				K8SClient:           k8sClient,
+				K8SBee:              k8sBee,
 				Namespace:           namespace,
 				DisableNamespace:    disableNamespace,
+				Ledger:              c.ledger,
 			})
 
 			cicd := newCICDOptions(clefSignerEnable, dbCapacity, paymentEarly, paymentThreshold, paymentTolerance, swapEnable, swapEndpoint, swapFactoryAddress, swapInitialDeposit, nodeSelector, ingressClass)
@@ -152,41 +159,48 @@ and checks if chunks are synced to their closest nodes.`,
 			} else {
 				seed = random.Int64()
 			}
+			c.ledger.SetSeed(seed)
 
 			pusher := push.New(c.config.GetString(optionNamePushGateway), namespace)
 
 			if uploadChunks {
-				return pushsync.CheckChunks(cluster, pushsync.Options{
-					UploadNodeCount: c.config.GetInt(optionNameUploadNodeCount),
-					ChunksPerNode:   c.config.GetInt(optionNameChunksPerNode),
-					RetryDelay:      c.config.GetDuration(optionNameRetryDelay),
-					Seed:            seed,
+				return c.withChaos(cmd, func() error {
+					return pushsync.CheckChunks(cluster, pushsync.Options{
+						UploadNodeCount: c.config.GetInt(optionNameUploadNodeCount),
+						ChunksPerNode:   c.config.GetInt(optionNameChunksPerNode),
+						RetryDelay:      c.config.GetDuration(optionNameRetryDelay),
+						Seed:            seed,
+					})
 				})
 			}
 
 			if uploadLightChunks {
-				return pushsync.CheckLightChunks(cluster, pushsync.Options{
+				return c.withChaos(cmd, func() error {
+					return pushsync.CheckLightChunks(cluster, pushsync.Options{
+						UploadNodeCount: c.config.GetInt(optionNameUploadNodeCount),
+						ChunksPerNode:   c.config.GetInt(optionNameChunksPerNode),
+						RetryDelay:      c.config.GetDuration(optionNameRetryDelay),
+						Seed:            seed,
+						PostageAmount:   c.config.GetInt64(optionNamePostageAmount),
+						PostageWait:     c.config.GetDuration(optionNamePostageBatchhWait),
+						PostageDepth:    c.config.GetUint64(optionNamePostageDepth),
+					})
+				})
+			}
+
+			retryDelayDuration := c.config.GetDuration(optionNameRetryDelay)
+			return c.withChaos(cmd, func() error {
+				return pushsync.Check(cluster, pushsync.Options{
 					UploadNodeCount: c.config.GetInt(optionNameUploadNodeCount),
 					ChunksPerNode:   c.config.GetInt(optionNameChunksPerNode),
-					RetryDelay:      c.config.GetDuration(optionNameRetryDelay),
+					Retries:         c.config.GetInt(optionNameRetries),
+					RetryDelay:      retryDelayDuration,
 					Seed:            seed,
 					PostageAmount:   c.config.GetInt64(optionNamePostageAmount),
 					PostageWait:     c.config.GetDuration(optionNamePostageBatchhWait),
 					PostageDepth:    c.config.GetUint64(optionNamePostageDepth),
-				})
-			}
-
-			retryDelayDuration := c.config.GetDuration(optionNameRetryDelay)
-			return pushsync.Check(cluster, pushsync.Options{
-				UploadNodeCount: c.config.GetInt(optionNameUploadNodeCount),
-				ChunksPerNode:   c.config.GetInt(optionNameChunksPerNode),
-				Retries:         c.config.GetInt(optionNameRetries),
-				RetryDelay:      retryDelayDuration,
-				Seed:            seed,
-				PostageAmount:   c.config.GetInt64(optionNamePostageAmount),
-				PostageWait:     c.config.GetDuration(optionNamePostageBatchhWait),
-				PostageDepth:    c.config.GetUint64(optionNamePostageDepth),
-			}, pusher, c.config.GetBool(optionNamePushMetrics))
+				}, pusher, c.config.GetBool(optionNamePushMetrics))
+			})
 		},
 		PreRunE: c.checkPreRunE,
 	}
@@ -72,6 +72,11 @@ and attempts retrieval of those chunks from the last node in the cluster.`,
 				return fmt.Errorf("creating Kubernetes client: %w", err)
 			}
 
+			k8sBee, err := c.newK8SBee(k8sClient)
+			if err != nil {
+				return fmt.Errorf("creating Kubernetes Bee client: %w", err)
+			}
+
 			namespace := c.config.GetString(optionNameNamespace)
 			cluster := bee.NewCluster(clusterName, bee.ClusterOptions{
 				APIDomain:           c.config.GetString(optionNameAPIDomain),
@@ -81,8 +86,10 @@ and attempts retrieval of those chunks from the last node in the cluster.`,
 				DebugAPIInsecureTLS: insecureTLSDebugAPI,
 				DebugAPIScheme:      c.config.GetString(optionNameDebugAPIScheme),
 				K8SClient:           k8sClient,
+				K8SBee:              k8sBee,
 				Namespace:           namespace,
 				DisableNamespace:    disableNamespace,
+				Ledger:              c.ledger,
 			})
 
 			cicd := newCICDOptions(clefSignerEnable, dbCapacity, paymentEarly, paymentThreshold, paymentTolerance, swapEnable, swapEndpoint, swapFactoryAddress, swapInitialDeposit, nodeSelector, ingressClass)
@@ -143,15 +150,18 @@ and attempts retrieval of those chunks from the last node in the cluster.`,
 			} else {
 				seed = random.Int64()
 			}
-
-			return retrieval.Check(cluster, retrieval.Options{
-				UploadNodeCount: c.config.GetInt(optionNameUploadNodeCount),
-				ChunksPerNode:   c.config.GetInt(optionNameChunksPerNode),
-				Seed:            seed,
-				PostageAmount:   c.config.GetInt64(optionNamePostageAmount),
-				PostageWait:     c.config.GetDuration(optionNamePostageBatchhWait),
-				PostageDepth:    c.config.GetUint64(optionNamePostageDepth),
-			}, pusher, c.config.GetBool(optionNamePushMetrics))
+			c.ledger.SetSeed(seed)
+
+			return c.withChaos(cmd, func() error {
+				return retrieval.Check(cluster, retrieval.Options{
+					UploadNodeCount: c.config.GetInt(optionNameUploadNodeCount),
+					ChunksPerNode:   c.config.GetInt(optionNameChunksPerNode),
+					Seed:            seed,
+					PostageAmount:   c.config.GetInt64(optionNamePostageAmount),
+					PostageWait:     c.config.GetDuration(optionNamePostageBatchhWait),
+					PostageDepth:    c.config.GetUint64(optionNamePostageDepth),
+				}, pusher, c.config.GetBool(optionNamePushMetrics))
+			})
 		},
 		PreRunE: c.checkPreRunE,
 	}
@@ -74,6 +74,11 @@ func (c *command) initCheckSettlements() *cobra.Command {
 				return fmt.Errorf("creating Kubernetes client: %w", err)
 			}
 
+			k8sBee, err := c.newK8SBee(k8sClient)
+			if err != nil {
+				return fmt.Errorf("creating Kubernetes Bee client: %w", err)
+			}
+
 			namespace := c.config.GetString(optionNameNamespace)
 			cluster := bee.NewCluster(clusterName, bee.ClusterOptions{
 				APIDomain:           c.config.GetString(optionNameAPIDomain),
@@ -83,8 +88,10 @@ func (c *command) initCheckSettlements() *cobra.Command {
 				DebugAPIInsecureTLS: insecureTLSDebugAPI,
 				DebugAPIScheme:      c.config.GetString(optionNameDebugAPIScheme),
 				K8SClient:           k8sClient,
+				K8SBee:              k8sBee,
 				Namespace:           namespace,
 				DisableNamespace:    disableNamespace,
+				Ledger:              c.ledger,
 			})
 
 			cicd := newCICDOptions(clefSignerEnable, dbCapacity, paymentEarly, paymentThreshold, paymentTolerance, swapEnable, swapEndpoint, swapFactoryAddress, swapInitialDeposit, nodeSelector, ingressClass)
@@ -145,11 +152,26 @@ func (c *command) initCheckSettlements() *cobra.Command {
 			} else {
 				seed = random.Int64()
 			}
+			c.ledger.SetSeed(seed)
 
 			fileSize := round(c.config.GetFloat64(optionNameFileSize) * 1024 * 1024)
 
 			if dryRun {
-				return settlements.DryRunCheck(cluster, settlements.Options{
+				return c.withChaos(cmd, func() error {
+					return settlements.DryRunCheck(cluster, settlements.Options{
+						UploadNodeCount:    c.config.GetInt(optionNameUploadNodeCount),
+						FileName:           c.config.GetString(optionNameFileName),
+						FileSize:           fileSize,
+						Seed:               seed,
+						Threshold:          c.config.GetInt64(optionNameThreshold),
+						WaitBeforeDownload: c.config.GetDuration(optionNameWaitBeforeDownload),
+						ExpectSettlements:  c.config.GetBool(optionNameExpectSettlements),
+					})
+				})
+			}
+
+			return c.withChaos(cmd, func() error {
+				return settlements.Check(cluster, settlements.Options{
 					UploadNodeCount:    c.config.GetInt(optionNameUploadNodeCount),
 					FileName:           c.config.GetString(optionNameFileName),
 					FileSize:           fileSize,
@@ -157,21 +179,11 @@ func (c *command) initCheckSettlements() *cobra.Command {
 					Threshold:          c.config.GetInt64(optionNameThreshold),
 					WaitBeforeDownload: c.config.GetDuration(optionNameWaitBeforeDownload),
 					ExpectSettlements:  c.config.GetBool(optionNameExpectSettlements),
-				})
-			}
-
-			return settlements.Check(cluster, settlements.Options{
-				UploadNodeCount:    c.config.GetInt(optionNameUploadNodeCount),
-				FileName:           c.config.GetString(optionNameFileName),
-				FileSize:           fileSize,
-				Seed:               seed,
-				Threshold:          c.config.GetInt64(optionNameThreshold),
-				WaitBeforeDownload: c.config.GetDuration(optionNameWaitBeforeDownload),
-				ExpectSettlements:  c.config.GetBool(optionNameExpectSettlements),
-				PostageAmount:      c.config.GetInt64(optionNamePostageAmount),
-				PostageWait:        c.config.GetDuration(optionNamePostageBatchhWait),
-				PostageDepth:       c.config.GetUint64(optionNamePostageDepth),
-			}, pusher, c.config.GetBool(optionNamePushMetrics))
+					PostageAmount:      c.config.GetInt64(optionNamePostageAmount),
+					PostageWait:        c.config.GetDuration(optionNamePostageBatchhWait),
+					PostageDepth:       c.config.GetUint64(optionNamePostageDepth),
+				}, pusher, c.config.GetBool(optionNamePushMetrics))
+			})
 		},
 		PreRunE: c.checkPreRunE,
 	}
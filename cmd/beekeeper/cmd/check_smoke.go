@@ -18,6 +18,7 @@ func (c *command) initCheckSmoke() *cobra.Command {
 		optionNameMegabytes = "megabytes"
 		optionNameSeed      = "seed"
 		optionNameTimeout   = "timeout"
+		optionNameEncrypt   = "encrypt"
 	)
 
 	var (
@@ -41,6 +42,7 @@ func (c *command) initCheckSmoke() *cobra.Command {
 				DebugAPIScheme:      c.config.GetString(optionNameDebugAPIScheme),
 				Namespace:           c.config.GetString(optionNameNamespace),
 				DisableNamespace:    disableNamespace,
+				Ledger:              c.ledger,
 			})
 
 			var b, mb = c.config.GetInt(optionNameBytes), c.config.GetInt(optionNameMegabytes)
@@ -68,18 +70,22 @@ func (c *command) initCheckSmoke() *cobra.Command {
 			} else {
 				seed = random.Int64()
 			}
+			c.ledger.SetSeed(seed)
 
 			t := c.config.GetDuration(optionNameTimeout)
 
 			ts := t * 1000000000
 
-			return smoke.Check(cluster, smoke.Options{
-				NodeGroup:       "nodes",
-				UploadNodeCount: c.config.GetInt(optionNameNodeCount),
-				Seed:            seed,
-				Runs:            runs,
-				Bytes:           b,
-				Timeout:         ts,
+			return c.withChaos(cmd, func() error {
+				return smoke.Check(cluster, smoke.Options{
+					NodeGroup:       "nodes",
+					UploadNodeCount: c.config.GetInt(optionNameNodeCount),
+					Seed:            seed,
+					Runs:            runs,
+					Bytes:           b,
+					Timeout:         ts,
+					Encrypt:         c.config.GetBool(optionNameEncrypt),
+				})
 			})
 		},
 		PreRunE: c.checkPreRunE,
@@ -90,6 +96,7 @@ func (c *command) initCheckSmoke() *cobra.Command {
 	cmd.Flags().IntP(optionNameBytes, "b", 0, "number of bytes to upload on each run")
 	cmd.Flags().IntP(optionNameMegabytes, "m", 0, "number of megabytes to upload on each run")
 	cmd.Flags().IntP(optionNameTimeout, "t", 0, "number of seconds before sync times out")
+	cmd.Flags().Bool(optionNameEncrypt, false, "upload data encrypted")
 
 	return cmd
 }
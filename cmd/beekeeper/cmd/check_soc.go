@@ -63,6 +63,11 @@ func (c *command) initCheckSOC() *cobra.Command {
 				return fmt.Errorf("creating Kubernetes client: %w", err)
 			}
 
+			k8sBee, err := c.newK8SBee(k8sClient)
+			if err != nil {
+				return fmt.Errorf("creating Kubernetes Bee client: %w", err)
+			}
+
 			namespace := c.config.GetString(optionNameNamespace)
 			cluster := bee.NewCluster(clusterName, bee.ClusterOptions{
 				APIDomain:           c.config.GetString(optionNameAPIDomain),
@@ -72,8 +77,10 @@ func (c *command) initCheckSOC() *cobra.Command {
 				DebugAPIInsecureTLS: insecureTLSDebugAPI,
 				DebugAPIScheme:      c.config.GetString(optionNameDebugAPIScheme),
 				K8SClient:           k8sClient,
+				K8SBee:              k8sBee,
 				Namespace:           namespace,
 				DisableNamespace:    disableNamespace,
+				Ledger:              c.ledger,
 			})
 
 			cicd := newCICDOptions(clefSignerEnable, dbCapacity, paymentEarly, paymentThreshold, paymentTolerance, swapEnable, swapEndpoint, swapFactoryAddress, swapInitialDeposit, nodeSelector, ingressClass)
@@ -128,12 +135,14 @@ func (c *command) initCheckSOC() *cobra.Command {
 
 			pusher := push.New(c.config.GetString(optionNamePushGateway), namespace)
 
-			return soc.Check(cluster, soc.Options{
-				PostageAmount:  c.config.GetInt64(optionNamePostageAmount),
-				PostageWait:    c.config.GetDuration(optionNamePostageBatchhWait),
-				PostageDepth:   c.config.GetUint64(optionNamePostageDepth),
-				RequestTimeout: c.config.GetDuration(optionTimeout),
-			}, pusher, c.config.GetBool(optionNamePushMetrics))
+			return c.withChaos(cmd, func() error {
+				return soc.Check(cluster, soc.Options{
+					PostageAmount:  c.config.GetInt64(optionNamePostageAmount),
+					PostageWait:    c.config.GetDuration(optionNamePostageBatchhWait),
+					PostageDepth:   c.config.GetUint64(optionNamePostageDepth),
+					RequestTimeout: c.config.GetDuration(optionTimeout),
+				}, pusher, c.config.GetBool(optionNamePushMetrics))
+			})
 		},
 		PreRunE: c.checkPreRunE,
 	}
@@ -6,6 +6,7 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/ethersphere/beekeeper/pkg/ledger"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
@@ -19,6 +20,8 @@ type command struct {
 	config  *viper.Viper
 	cfgFile string
 	homeDir string
+	// ledger records content uploaded by checks and stress, if set
+	ledger *ledger.Ledger
 }
 
 type option func(*command)
@@ -75,10 +78,18 @@ func newCommand(opts ...option) (c *command, err error) {
 		return nil, err
 	}
 
+	if err := c.initTurnUpdateCmd(); err != nil {
+		return nil, err
+	}
+
 	if err := c.initTurnOffCmd(); err != nil {
 		return nil, err
 	}
 
+	if err := c.initChaosCmd(); err != nil {
+		return nil, err
+	}
+
 	if err := c.initHelmCmd(); err != nil {
 		return nil, err
 	}
@@ -1,16 +1,26 @@
 package cmd
 
 import (
+	"fmt"
+	"time"
+
+	"github.com/ethersphere/beekeeper/pkg/helm3"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
 
 const (
-	optionNameHelmConfig    = "kubeconfig"
-	optionNameHelmNamespace = "namespace"
-	optionNameRelease       = "release"
-	optionNameChart         = "chart"
-	optionNameArgs          = "args"
+	optionNameHelmConfig      = "kubeconfig"
+	optionNameHelmNamespace   = "namespace"
+	optionNameRelease         = "release"
+	optionNameChart           = "chart"
+	optionNameHelmVersion     = "version"
+	optionNameHelmValues      = "values"
+	optionNameHelmSet         = "set"
+	optionNameHelmReuseValues = "reuse-values"
+	optionNameHelmWait        = "wait"
+	optionNameHelmTimeout     = "timeout"
+	optionNameHelmRevision    = "revision"
 )
 
 func (c *command) initHelmCmd() (err error) {
@@ -27,11 +37,15 @@ func (c *command) initHelmCmd() (err error) {
 	viper.AutomaticEnv()
 	cmd.PersistentFlags().String(optionNameHelmConfig, viper.GetString("KUBECONFIG"), "kubernetes config file")
 	cmd.PersistentFlags().StringP(optionNameHelmNamespace, "n", "bee", "kubernetes namespace")
-	cmd.PersistentFlags().String(optionNameRelease, "bee", "defines the mode to run chaos scenario [one|all|fixed|fixed-percent|random-max-percent]")
-	cmd.PersistentFlags().String(optionNameChart, "ethersphere/bee", "depends on the mode, for one and all leave empty")
-	cmd.PersistentFlags().String(optionNameArgs, "", "if not specified it will use random bee pod from the namespace")
+	cmd.PersistentFlags().String(optionNameRelease, "bee", "helm release name")
+	cmd.PersistentFlags().String(optionNameChart, "ethersphere/bee", "helm chart reference, path or URL")
 
+	cmd.AddCommand(c.initHelmInstall())
 	cmd.AddCommand(c.initHelmUpgrade())
+	cmd.AddCommand(c.initHelmUninstall())
+	cmd.AddCommand(c.initHelmRollback())
+	cmd.AddCommand(c.initHelmStatus())
+	cmd.AddCommand(c.initHelmList())
 
 	c.root.AddCommand(cmd)
 	return nil
@@ -44,3 +58,32 @@ func (c *command) helmPreRunE(cmd *cobra.Command, args []string) (err error) {
 
 	return
 }
+
+// helmClient returns Helm client for the configured kubeconfig and namespace
+func (c *command) helmClient() (*helm3.Client, error) {
+	return helm3.NewClient(c.config.GetString(optionNameHelmConfig), c.config.GetString(optionNameHelmNamespace))
+}
+
+// addHelmChartFlags adds flags shared by install and upgrade commands
+func addHelmChartFlags(cmd *cobra.Command, valuesFiles, set *[]string) {
+	cmd.Flags().String(optionNameHelmVersion, "", "chart version, latest if not specified")
+	cmd.Flags().StringArrayVarP(valuesFiles, optionNameHelmValues, "f", nil, "values file, can be specified multiple times")
+	cmd.Flags().StringArrayVar(set, optionNameHelmSet, nil, "set values (key1=val1,key2=val2), can be specified multiple times")
+	cmd.Flags().Bool(optionNameHelmWait, true, "wait until all resources are ready")
+	cmd.Flags().Duration(optionNameHelmTimeout, 5*time.Minute, "time to wait for kubernetes operations")
+}
+
+// helmOptions returns chart options from flags added by addHelmChartFlags
+func (c *command) helmOptions(valuesFiles, set []string) helm3.Options {
+	return helm3.Options{
+		Version:     c.config.GetString(optionNameHelmVersion),
+		ValuesFiles: valuesFiles,
+		Set:         set,
+		Wait:        c.config.GetBool(optionNameHelmWait),
+		Timeout:     c.config.GetDuration(optionNameHelmTimeout),
+	}
+}
+
+func printHelmRelease(r helm3.Release) {
+	fmt.Printf("release %s in namespace %s: revision %d, status %s, chart %s\n", r.Name, r.Namespace, r.Revision, r.Status, r.Chart)
+}
@@ -0,0 +1,34 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+func (c *command) initHelmInstall() *cobra.Command {
+	var valuesFiles, set []string
+
+	cmd := &cobra.Command{
+		Use:   "install",
+		Short: "helm install",
+		Long:  `Installs chart as a new helm release.`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			client, err := c.helmClient()
+			if err != nil {
+				return err
+			}
+
+			r, err := client.Install(c.config.GetString(optionNameRelease), c.config.GetString(optionNameChart), c.helmOptions(valuesFiles, set))
+			if err != nil {
+				return err
+			}
+			printHelmRelease(r)
+
+			return
+		},
+		PreRunE: c.helmPreRunE,
+	}
+
+	addHelmChartFlags(cmd, &valuesFiles, &set)
+
+	return cmd
+}
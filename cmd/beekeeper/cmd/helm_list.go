@@ -0,0 +1,41 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+func (c *command) initHelmList() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "helm list",
+		Long:  `Lists helm releases in the namespace.`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			client, err := c.helmClient()
+			if err != nil {
+				return err
+			}
+
+			releases, err := client.List()
+			if err != nil {
+				return err
+			}
+			if len(releases) == 0 {
+				fmt.Println("no helm releases")
+				return
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "NAME\tREVISION\tSTATUS\tCHART\tAPP VERSION\tUPDATED")
+			for _, r := range releases {
+				fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%s\t%s\n", r.Name, r.Revision, r.Status, r.Chart, r.AppVersion, r.Updated.Format(time.RFC1123))
+			}
+			return w.Flush()
+		},
+		PreRunE: c.helmPreRunE,
+	}
+}
@@ -0,0 +1,41 @@
+package cmd
+
+import (
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+func (c *command) initHelmRollback() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rollback",
+		Short: "helm rollback",
+		Long:  `Rolls helm release back to the given revision, or to the previous one if revision is not specified.`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			client, err := c.helmClient()
+			if err != nil {
+				return err
+			}
+
+			release := c.config.GetString(optionNameRelease)
+			if err := client.Rollback(release, c.config.GetInt(optionNameHelmRevision), c.config.GetBool(optionNameHelmWait), c.config.GetDuration(optionNameHelmTimeout)); err != nil {
+				return err
+			}
+
+			r, err := client.Status(release)
+			if err != nil {
+				return err
+			}
+			printHelmRelease(r)
+
+			return
+		},
+		PreRunE: c.helmPreRunE,
+	}
+
+	cmd.Flags().Int(optionNameHelmRevision, 0, "revision to roll back to, previous revision if 0")
+	cmd.Flags().Bool(optionNameHelmWait, true, "wait until all resources are ready")
+	cmd.Flags().Duration(optionNameHelmTimeout, 5*time.Minute, "time to wait for kubernetes operations")
+
+	return cmd
+}
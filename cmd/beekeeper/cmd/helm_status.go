@@ -0,0 +1,38 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+func (c *command) initHelmStatus() *cobra.Command {
+	return &cobra.Command{
+		Use:   "status",
+		Short: "helm status",
+		Long:  `Shows status of the helm release.`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			client, err := c.helmClient()
+			if err != nil {
+				return err
+			}
+
+			r, err := client.Status(c.config.GetString(optionNameRelease))
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("NAME: %s\n", r.Name)
+			fmt.Printf("NAMESPACE: %s\n", r.Namespace)
+			fmt.Printf("REVISION: %d\n", r.Revision)
+			fmt.Printf("STATUS: %s\n", r.Status)
+			fmt.Printf("CHART: %s\n", r.Chart)
+			fmt.Printf("APP VERSION: %s\n", r.AppVersion)
+			fmt.Printf("LAST DEPLOYED: %s\n", r.Updated.Format(time.RFC1123))
+
+			return
+		},
+		PreRunE: c.helmPreRunE,
+	}
+}
@@ -0,0 +1,35 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+func (c *command) initHelmUninstall() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "uninstall",
+		Short: "helm uninstall",
+		Long:  `Uninstalls helm release and removes all its resources.`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			client, err := c.helmClient()
+			if err != nil {
+				return err
+			}
+
+			release := c.config.GetString(optionNameRelease)
+			if err := client.Uninstall(release, c.config.GetDuration(optionNameHelmTimeout)); err != nil {
+				return err
+			}
+			fmt.Printf("release %s uninstalled\n", release)
+
+			return
+		},
+		PreRunE: c.helmPreRunE,
+	}
+
+	cmd.Flags().Duration(optionNameHelmTimeout, 5*time.Minute, "time to wait for kubernetes operations")
+
+	return cmd
+}
@@ -1,32 +1,38 @@
 package cmd
 
 import (
-	"github.com/ethersphere/beekeeper/pkg/helm3"
 	"github.com/spf13/cobra"
 )
 
 func (c *command) initHelmUpgrade() *cobra.Command {
-	return &cobra.Command{
+	var valuesFiles, set []string
+
+	cmd := &cobra.Command{
 		Use:   "upgrade",
 		Short: "helm upgrade",
-		Long:  `helm upgrade.`,
+		Long:  `Upgrades helm release to a new version of the chart.`,
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
-			kubeconfig := c.config.GetString(optionNameHelmConfig)
-			namespace := c.config.GetString(optionNameHelmNamespace)
-			release := c.config.GetString(optionNameRelease)
-			chart := c.config.GetString(optionNameChart)
-			vals := c.config.GetString(optionNameArgs)
-
-			values := map[string]string{
-				"set": vals,
+			client, err := c.helmClient()
+			if err != nil {
+				return err
 			}
 
-			err = helm3.Upgrade(kubeconfig, namespace, release, chart, values)
+			o := c.helmOptions(valuesFiles, set)
+			o.ReuseValues = c.config.GetBool(optionNameHelmReuseValues)
+
+			r, err := client.Upgrade(c.config.GetString(optionNameRelease), c.config.GetString(optionNameChart), o)
 			if err != nil {
 				return err
 			}
+			printHelmRelease(r)
+
 			return
 		},
 		PreRunE: c.helmPreRunE,
 	}
+
+	addHelmChartFlags(cmd, &valuesFiles, &set)
+	cmd.Flags().Bool(optionNameHelmReuseValues, true, "reuse values from the last release and merge in overrides")
+
+	return cmd
 }
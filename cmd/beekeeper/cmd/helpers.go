@@ -4,9 +4,12 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/ethersphere/beekeeper/pkg/bee"
+	"github.com/ethersphere/beekeeper/pkg/chaos"
 	"github.com/ethersphere/beekeeper/pkg/k8s"
+	k8shelm "github.com/ethersphere/beekeeper/pkg/k8s/helm"
 	"github.com/ethersphere/beekeeper/pkg/stress"
 	"golang.org/x/sync/errgroup"
 )
@@ -351,6 +354,59 @@ func setK8SClient(kubeconfig string, inCluster bool) (c *k8s.Client, err error)
 	return c, nil
 }
 
+const (
+	beeBackendK8S  = "k8s"
+	beeBackendHelm = "helm"
+)
+
+// newK8SBee returns Kubernetes Bee client selected by the bee-backend option,
+// nil means that the cluster builds the default client from k8sClient
+func (c *command) newK8SBee(k8sClient *k8s.Client) (k8s.Bee, error) {
+	switch backend := c.config.GetString(optionNameBeeBackend); backend {
+	case "", beeBackendK8S:
+		return nil, nil
+	case beeBackendHelm:
+		if k8sClient == nil {
+			return nil, nil
+		}
+		kubeconfig := c.config.GetString(optionNameKubeconfig)
+		if c.config.GetBool(optionNameInCluster) {
+			kubeconfig = "incluster"
+		}
+		return k8shelm.NewClient(k8sClient, kubeconfig, k8shelm.ClientOptions{
+			Chart:       c.config.GetString(optionNameBeeChart),
+			Version:     c.config.GetString(optionNameBeeChartVersion),
+			ValuesFiles: c.config.GetStringSlice(optionNameBeeChartValues),
+			Timeout:     5 * time.Minute,
+		}), nil
+	default:
+		return nil, fmt.Errorf("unknown bee backend %q, must be one of %s, %s", backend, beeBackendK8S, beeBackendHelm)
+	}
+}
+
+// newChaosBackend returns chaos backend by its name; kubeconfig is the path
+// to the kubernetes config file, default config is used if it is not set
+func newChaosBackend(name, kubeconfig string, inCluster bool, namespace string) (chaos.Backend, error) {
+	switch name {
+	case chaos.BackendChaosMesh:
+		if inCluster {
+			kubeconfig = "incluster"
+		}
+		return chaos.NewChaosMesh(kubeconfig, namespace), nil
+	case chaos.BackendNative:
+		if kubeconfig == "" {
+			kubeconfig = "~/.kube/config"
+		}
+		k8sClient, err := setK8SClient(kubeconfig, inCluster)
+		if err != nil {
+			return nil, err
+		}
+		return chaos.NewNative(k8sClient, namespace), nil
+	default:
+		return nil, fmt.Errorf("unknown chaos backend %q, must be one of %s, %s", name, chaos.BackendChaosMesh, chaos.BackendNative)
+	}
+}
+
 var stressStages = []stress.Stage{
 	[]stress.Update{
 		{
@@ -24,6 +24,10 @@ func (c *command) initStartCmd() (err error) {
 	cmd.PersistentFlags().BoolVar(&insecureTLSDebugAPI, optionNameDebugAPIInsecureTLS, false, "skips TLS verification for debug API")
 	cmd.PersistentFlags().String(optionNameDebugAPIScheme, "https", "debug API scheme")
 	cmd.PersistentFlags().String(optionNameKubeconfig, "", "kubernetes config file")
+	cmd.PersistentFlags().String(optionNameBeeBackend, beeBackendK8S, "backend that manages Bee nodes in the kubernetes cluster [k8s|helm]")
+	cmd.PersistentFlags().String(optionNameBeeChart, "ethersphere/bee", "Bee helm chart reference, path or URL, used by helm backend")
+	cmd.PersistentFlags().String(optionNameBeeChartVersion, "", "Bee helm chart version, latest if not specified, used by helm backend")
+	cmd.PersistentFlags().StringSlice(optionNameBeeChartValues, nil, "Bee helm chart values files, comma separated or specified multiple times, used by helm backend")
 	cmd.PersistentFlags().StringP(optionNameNamespace, "n", "beekeeper", "kubernetes namespace")
 
 	cmd.AddCommand(c.initAddStartNode())
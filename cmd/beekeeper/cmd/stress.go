@@ -1,9 +1,18 @@
 package cmd
 
 import (
+	"time"
+
+	"github.com/ethersphere/beekeeper/pkg/bee"
 	"github.com/spf13/cobra"
 )
 
+const (
+	optionNameDistribution      = "distribution"
+	optionNameDistributionDepth = "distribution-depth"
+	optionNameSLO               = "slo"
+)
+
 func (c *command) initStressCmd() (err error) {
 	cmd := &cobra.Command{
 		Use:   "stress",
@@ -32,8 +41,24 @@ func (c *command) initStressCmd() (err error) {
 	cmd.PersistentFlags().BoolVar(&pushMetrics, optionNamePushMetrics, false, "push metrics to pushgateway")
 	cmd.PersistentFlags().BoolVar(&inCluster, optionNameInCluster, false, "run Beekeeper in Kubernetes cluster")
 	cmd.PersistentFlags().String(optionNameKubeconfig, "", "kubernetes config file")
+	cmd.PersistentFlags().String(optionNameBeeBackend, beeBackendK8S, "backend that manages Bee nodes in the kubernetes cluster [k8s|helm]")
+	cmd.PersistentFlags().String(optionNameBeeChart, "ethersphere/bee", "Bee helm chart reference, path or URL, used by helm backend")
+	cmd.PersistentFlags().String(optionNameBeeChartVersion, "", "Bee helm chart version, latest if not specified, used by helm backend")
+	cmd.PersistentFlags().StringSlice(optionNameBeeChartValues, nil, "Bee helm chart values files, comma separated or specified multiple times, used by helm backend")
+	cmd.PersistentFlags().Int64(optionNamePostageAmount, 1, "postage stamp amount")
+	cmd.PersistentFlags().Duration(optionNamePostageBatchhWait, time.Minute*5, "maximum time to wait for batch to become usable")
+	cmd.PersistentFlags().String(optionNameLedger, "", "path to the ledger file in which uploaded content is recorded")
+	cmd.PersistentFlags().String(optionNameDistribution, bee.DistributionUniform.String(), "distribution of file chunk addresses relative to node overlays [uniform|neighbourhood|bins|zipf|targets|proximity]")
+	cmd.PersistentFlags().Uint8(optionNameDistributionDepth, 0, "neighbourhood depth or bin used by the chunk distribution")
+	cmd.PersistentFlags().String(optionNameSLO, "", "comma separated service level objectives, e.g. upload.p99<2s,error_rate<1%; stress fails if any is violated")
 
 	cmd.AddCommand(c.initStressUpload())
+	cmd.AddCommand(c.initStressDownload())
+	cmd.AddCommand(c.initStressLoad())
+
+	for _, stress := range cmd.Commands() {
+		stress.RunE = c.withLedger(stress.RunE)
+	}
 
 	c.root.AddCommand(cmd)
 	return nil
@@ -57,5 +82,5 @@ func (c *command) stressPreRunE(cmd *cobra.Command, args []string) (err error) {
 		insecureTLSDebugAPI = true
 	}
 
-	return
+	return c.openLedger(cmd)
 }
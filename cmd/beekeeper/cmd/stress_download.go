@@ -0,0 +1,268 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ethersphere/beekeeper/pkg/bee"
+	"github.com/ethersphere/beekeeper/pkg/random"
+	"github.com/ethersphere/beekeeper/pkg/stress"
+	"github.com/ethersphere/beekeeper/pkg/stress/download"
+	"github.com/prometheus/client_golang/prometheus/push"
+	"github.com/spf13/cobra"
+)
+
+func (c *command) initStressDownload() *cobra.Command {
+	const (
+		optionNameStartCluster             = "start-cluster"
+		optionNameDynamic                  = "dynamic"
+		optionNameClusterName              = "cluster-name"
+		optionNameBootnodeCount            = "bootnode-count"
+		optionNameNodeCount                = "node-count"
+		optionNameImage                    = "bee-image"
+		optionNameImagePullSecrets         = "image-pull-secrets"
+		optionNameFullNode                 = "full-node"
+		optionNamePersistence              = "persistence"
+		optionNameStorageClass             = "storage-class"
+		optionNameStorageRequest           = "storage-request"
+		optionNameAdditionalNodeCount      = "additional-node-count"
+		optionNameAdditionalImage          = "additional-bee-image"
+		optionNameAdditionalFullNode       = "additional-full-node"
+		optionNameAdditionalPersistence    = "additional-persistence"
+		optionNameAdditionalStorageClass   = "additional-storage-class"
+		optionNameAdditionalStorageRequest = "additional-storage-request"
+		optionNameSeed                     = "seed"
+		optionNameDownloadNodesPercentage  = "download-nodes-percentage"
+		optionNameCorpusSize               = "corpus-size"
+		optionNamePopularity               = "popularity"
+		optionNameZipfS                    = "zipf-s"
+		optionNameTimeout                  = "timeout"
+		optionNameFileSize                 = "file-size"
+		optionNameRetries                  = "retries"
+		optionNameRetryDelay               = "retry-delay"
+		// CICD options
+		optionNameClefSignerEnable   = "clef-signer-enable"
+		optionNameDBCapacity         = "db-capacity"
+		optionNamePaymentEarly       = "payment-early"
+		optionNamePaymentThreshold   = "payment-threshold"
+		optionNamePaymentTolerance   = "payment-tolerance"
+		optionNameSwapEnable         = "swap-enable"
+		optionNameSwapEndpoint       = "swap-endpoint"
+		optionNameSwapFactoryAddress = "swap-factory-address"
+		optionNameSwapInitialDeposit = "swap-initial-deposit"
+		optionNameNodeSelector       = "node-selector"
+		optionNameIngressClass       = "ingress-class"
+	)
+
+	var (
+		startCluster             bool
+		dynamic                  bool
+		clusterName              string
+		imagePullSecrets         []string
+		bootnodeCount            int
+		nodeCount                int
+		image                    string
+		fullNode                 bool
+		persistence              bool
+		storageClass             string
+		storageRequest           string
+		additionalNodeCount      int
+		additionalImage          string
+		additionalFullNode       bool
+		additionalPersistence    bool
+		additionalStorageClass   string
+		additionalStorageRequest string
+		downloadNodesPercentage  int
+		// CICD options
+		clefSignerEnable   bool
+		dbCapacity         uint64
+		paymentEarly       uint64
+		paymentThreshold   uint64
+		paymentTolerance   uint64
+		swapEnable         bool
+		swapEndpoint       string
+		swapFactoryAddress string
+		swapInitialDeposit uint64
+		nodeSelector       string
+		ingressClass       string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "download",
+		Short: "Downloads data from nodes in the cluster",
+		Long: `Downloads data from nodes in the cluster.
+It uploads a corpus of files to random nodes, then a given percentage of nodes
+concurrently downloads files drawn from the corpus by a uniform or Zipf
+popularity distribution and verifies their hashes. Throughput and latency
+of downloads are reported.`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			k8sClient, err := setK8SClient(c.config.GetString(optionNameKubeconfig), c.config.GetBool(optionNameInCluster))
+			if err != nil {
+				return fmt.Errorf("creating Kubernetes client: %w", err)
+			}
+
+			k8sBee, err := c.newK8SBee(k8sClient)
+			if err != nil {
+				return fmt.Errorf("creating Kubernetes Bee client: %w", err)
+			}
+
+			namespace := c.config.GetString(optionNameNamespace)
+			cluster := bee.NewCluster(clusterName, bee.ClusterOptions{
+				APIDomain:           c.config.GetString(optionNameAPIDomain),
+				APIInsecureTLS:      insecureTLSAPI,
+				APIScheme:           c.config.GetString(optionNameAPIScheme),
+				DebugAPIDomain:      c.config.GetString(optionNameDebugAPIDomain),
+				DebugAPIInsecureTLS: insecureTLSDebugAPI,
+				DebugAPIScheme:      c.config.GetString(optionNameDebugAPIScheme),
+				K8SClient:           k8sClient,
+				K8SBee:              k8sBee,
+				Namespace:           namespace,
+				DisableNamespace:    disableNamespace,
+				Ledger:              c.ledger,
+			})
+
+			cicd := newCICDOptions(clefSignerEnable, dbCapacity, paymentEarly, paymentThreshold, paymentTolerance, swapEnable, swapEndpoint, swapFactoryAddress, swapInitialDeposit, nodeSelector, ingressClass)
+
+			if startCluster {
+				// bootnodes group
+				bgName := "bootnode"
+				bCtx, bCancel := context.WithTimeout(cmd.Context(), 10*time.Minute)
+				defer bCancel()
+				if err := startBootNodeGroup(bCtx, cluster, bootnodeCount, nodeCount, bgName, namespace, image, storageClass, storageRequest, imagePullSecrets, persistence, cicd); err != nil {
+					return fmt.Errorf("starting bootnode group %s: %w", bgName, err)
+				}
+
+				// node groups
+				ngName := "bee"
+				nCtx, nCancel := context.WithTimeout(cmd.Context(), 10*time.Minute)
+				defer nCancel()
+				if err := startNodeGroup(nCtx, cluster, bootnodeCount, nodeCount, ngName, namespace, image, storageClass, storageRequest, imagePullSecrets, persistence, fullNode, cicd); err != nil {
+					return fmt.Errorf("starting node group %s: %w", ngName, err)
+				}
+
+				if additionalNodeCount > 0 {
+					addNgName := "drone"
+					addNCtx, addNCancel := context.WithTimeout(cmd.Context(), 10*time.Minute)
+					defer addNCancel()
+					if err := startNodeGroup(addNCtx, cluster, bootnodeCount, additionalNodeCount, addNgName, namespace, additionalImage, additionalStorageClass, additionalStorageRequest, imagePullSecrets, additionalPersistence, additionalFullNode, cicd); err != nil {
+						return fmt.Errorf("starting node group %s: %w", addNgName, err)
+					}
+				}
+			} else {
+				// bootnodes group
+				if bootnodeCount > 0 {
+					bgName := "bootnode"
+					if err := addBootNodeGroup(cluster, bootnodeCount, nodeCount, bgName, namespace, image, storageClass, storageRequest, persistence); err != nil {
+						return fmt.Errorf("adding bootnode group %s: %w", bgName, err)
+					}
+				}
+
+				// nodes group
+				ngName := "bee"
+				if err := addNodeGroup(cluster, bootnodeCount, nodeCount, ngName, namespace, image, storageClass, storageRequest, persistence); err != nil {
+					return fmt.Errorf("adding node group %s: %w", ngName, err)
+				}
+
+				if additionalNodeCount > 0 {
+					addNgName := "drone"
+					if err := addNodeGroup(cluster, bootnodeCount, additionalNodeCount, addNgName, namespace, additionalImage, additionalStorageClass, additionalStorageRequest, additionalPersistence); err != nil {
+						return fmt.Errorf("starting node group %s: %w", addNgName, err)
+					}
+				}
+			}
+
+			var seed int64
+			if cmd.Flags().Changed("seed") {
+				seed = c.config.GetInt64(optionNameSeed)
+			} else {
+				seed = random.Int64()
+			}
+			c.ledger.SetSeed(seed)
+			buffer := 12
+
+			if downloadNodesPercentage < 0 || downloadNodesPercentage > 100 {
+				return fmt.Errorf("download-nodes-percentage must be number between 0 and 100")
+			}
+
+			distribution, err := bee.ParseDistribution(c.config.GetString(optionNameDistribution))
+			if err != nil {
+				return err
+			}
+
+			slo, err := stress.ParseSLO(c.config.GetString(optionNameSLO))
+			if err != nil {
+				return fmt.Errorf("parsing slo: %w", err)
+			}
+
+			stressDownload := download.NewDownload()
+			stressOptions := stress.Options{
+				FileSize:                round(c.config.GetFloat64(optionNameFileSize) * 1024 * 1024),
+				MetricsEnabled:          c.config.GetBool(optionNamePushMetrics),
+				MetricsPusher:           push.New(c.config.GetString(optionNamePushGateway), namespace),
+				Retries:                 c.config.GetInt(optionNameRetries),
+				RetryDelay:              c.config.GetDuration(optionNameRetryDelay),
+				Seed:                    seed,
+				Timeout:                 c.config.GetDuration(optionNameTimeout),
+				PostageAmount:           c.config.GetInt64(optionNamePostageAmount),
+				PostageWait:             c.config.GetDuration(optionNamePostageBatchhWait),
+				SLO:                     slo,
+				Distribution:            distribution,
+				DistributionDepth:       uint8(c.config.GetUint(optionNameDistributionDepth)),
+				CorpusSize:              c.config.GetInt(optionNameCorpusSize),
+				DownloadNodesPercentage: downloadNodesPercentage,
+				Popularity:              c.config.GetString(optionNamePopularity),
+				ZipfS:                   c.config.GetFloat64(optionNameZipfS),
+			}
+
+			dynamicStages := []stress.Stage{}
+			if dynamic {
+				dynamicStages = stressStages
+			}
+
+			return stress.RunConcurrently(cmd.Context(), cluster, stressDownload, stressOptions, dynamicStages, buffer, seed)
+		},
+		PreRunE: c.stressPreRunE,
+	}
+
+	cmd.Flags().BoolVar(&startCluster, optionNameStartCluster, false, "start new cluster")
+	cmd.Flags().BoolVar(&dynamic, optionNameDynamic, false, "stress on dynamic cluster")
+	cmd.Flags().StringVar(&clusterName, optionNameClusterName, "beekeeper", "cluster name")
+	cmd.Flags().StringArrayVar(&imagePullSecrets, optionNameImagePullSecrets, []string{"regcred"}, "image pull secrets")
+	cmd.Flags().IntVarP(&bootnodeCount, optionNameBootnodeCount, "b", 1, "number of bootnodes")
+	cmd.Flags().IntVarP(&nodeCount, optionNameNodeCount, "c", 1, "number of nodes")
+	cmd.Flags().StringVar(&image, optionNameImage, "ethersphere/bee:latest", "Bee Docker image")
+	cmd.PersistentFlags().BoolVar(&fullNode, optionNameFullNode, true, "start node in full mode")
+	cmd.PersistentFlags().BoolVar(&persistence, optionNamePersistence, true, "use persistent storage")
+	cmd.PersistentFlags().StringVar(&storageClass, optionNameStorageClass, "local-storage", "storage class name")
+	cmd.PersistentFlags().StringVar(&storageRequest, optionNameStorageRequest, "34Gi", "storage request")
+	cmd.Flags().IntVar(&additionalNodeCount, optionNameAdditionalNodeCount, 0, "number of nodes in additional node group")
+	cmd.Flags().StringVar(&additionalImage, optionNameAdditionalImage, "ethersphere/bee:latest", "Bee Docker image in additional node group")
+	cmd.PersistentFlags().BoolVar(&additionalFullNode, optionNameAdditionalFullNode, false, "start node in full mode")
+	cmd.PersistentFlags().BoolVar(&additionalPersistence, optionNameAdditionalPersistence, false, "use persistent storage")
+	cmd.PersistentFlags().StringVar(&additionalStorageClass, optionNameAdditionalStorageClass, "local-storage", "storage class name")
+	cmd.PersistentFlags().StringVar(&additionalStorageRequest, optionNameAdditionalStorageRequest, "34Gi", "storage request")
+	cmd.Flags().Int64P(optionNameSeed, "s", 0, "seed for generating files and choosing downloads; if not set, will be random")
+	cmd.PersistentFlags().IntVar(&downloadNodesPercentage, optionNameDownloadNodesPercentage, 50, "percentage of nodes to download from")
+	cmd.Flags().Int(optionNameCorpusSize, 100, "number of files in the corpus")
+	cmd.Flags().String(optionNamePopularity, download.PopularityZipf, "popularity distribution of corpus files: uniform or zipf")
+	cmd.Flags().Float64(optionNameZipfS, 1.2, "exponent of Zipf popularity distribution, must be greater than 1")
+	cmd.Flags().Duration(optionNameTimeout, 5*time.Minute, "how long to download files on each node")
+	cmd.Flags().Float64(optionNameFileSize, 1, "corpus file size in MB")
+	cmd.Flags().Int(optionNameRetries, 5, "number of reties on problems")
+	cmd.Flags().Duration(optionNameRetryDelay, time.Second, "retry delay duration")
+	// CICD options
+	cmd.Flags().BoolVar(&clefSignerEnable, optionNameClefSignerEnable, false, "enable Clef signer")
+	cmd.Flags().Uint64Var(&dbCapacity, optionNameDBCapacity, 5000000, "DB capacity")
+	cmd.Flags().Uint64Var(&paymentEarly, optionNamePaymentEarly, 100000000000, "payment early")
+	cmd.Flags().Uint64Var(&paymentThreshold, optionNamePaymentThreshold, 1000000000000, "payment threshold")
+	cmd.Flags().Uint64Var(&paymentTolerance, optionNamePaymentTolerance, 100000000000, "payment tolerance")
+	cmd.Flags().BoolVar(&swapEnable, optionNameSwapEnable, false, "enable swap")
+	cmd.Flags().StringVar(&swapEndpoint, optionNameSwapEndpoint, "ws://geth-swap.geth:8546", "swap endpoint")
+	cmd.Flags().StringVar(&swapFactoryAddress, optionNameSwapFactoryAddress, "0x657241f4494a2f15ba75346e691d753a978c72df", "swap factory address")
+	cmd.Flags().Uint64Var(&swapInitialDeposit, optionNameSwapInitialDeposit, 500000000000000000, "swap initial deposit")
+	cmd.Flags().StringVar(&nodeSelector, optionNameNodeSelector, "bee-staging", "node selector")
+	cmd.Flags().StringVar(&ingressClass, optionNameIngressClass, "nginx-internal", "ingress class")
+
+	return cmd
+}
@@ -34,10 +34,14 @@ func (c *command) initStressUpload() *cobra.Command {
 		optionNameAdditionalStorageRequest = "additional-storage-request"
 		optionNameSeed                     = "seed"
 		optionNameUploadNodesPercentage    = "upload-nodes-percentage"
+		optionNameUploadConcurrency        = "upload-concurrency"
 		optionNameTimeout                  = "timeout"
 		optionNameFileSize                 = "file-size"
 		optionNameRetries                  = "retries"
 		optionNameRetryDelay               = "retry-delay"
+		optionNameSoakInterval             = "soak-interval"
+		optionNameSoakSamples              = "soak-samples"
+		optionNameSoakAgeBuckets           = "soak-age-buckets"
 		// CICD options
 		optionNameClefSignerEnable   = "clef-signer-enable"
 		optionNameDBCapacity         = "db-capacity"
@@ -88,13 +92,23 @@ func (c *command) initStressUpload() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "upload",
 		Short: "Uploads data to all nodes in the cluster",
-		Long:  `Uploads data to all nodes in the cluster to ensure that the GC process is activated.`,
+		Long: `Uploads data to all nodes in the cluster to ensure that the GC process is activated.
+In soak mode, every uploaded reference is recorded in a ledger, and samples of
+previously uploaded content are periodically downloaded from random nodes other
+than the uploader to report retrievability of content by its age. The ledger
+set by the ledger flag is used if set, so that content uploaded by previous
+runs is verified too.`,
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			k8sClient, err := setK8SClient(c.config.GetString(optionNameKubeconfig), c.config.GetBool(optionNameInCluster))
 			if err != nil {
 				return fmt.Errorf("creating Kubernetes client: %w", err)
 			}
 
+			k8sBee, err := c.newK8SBee(k8sClient)
+			if err != nil {
+				return fmt.Errorf("creating Kubernetes Bee client: %w", err)
+			}
+
 			namespace := c.config.GetString(optionNameNamespace)
 			cluster := bee.NewCluster(clusterName, bee.ClusterOptions{
 				APIDomain:           c.config.GetString(optionNameAPIDomain),
@@ -104,8 +118,10 @@ func (c *command) initStressUpload() *cobra.Command {
 				DebugAPIInsecureTLS: insecureTLSDebugAPI,
 				DebugAPIScheme:      c.config.GetString(optionNameDebugAPIScheme),
 				K8SClient:           k8sClient,
+				K8SBee:              k8sBee,
 				Namespace:           namespace,
 				DisableNamespace:    disableNamespace,
+				Ledger:              c.ledger,
 			})
 
 			cicd := newCICDOptions(clefSignerEnable, dbCapacity, paymentEarly, paymentThreshold, paymentTolerance, swapEnable, swapEndpoint, swapFactoryAddress, swapInitialDeposit, nodeSelector, ingressClass)
@@ -164,13 +180,29 @@ func (c *command) initStressUpload() *cobra.Command {
 			} else {
 				seed = random.Int64()
 			}
+			c.ledger.SetSeed(seed)
 			buffer := 12
 
 			if uploadNodesPercentage < 0 || uploadNodesPercentage > 100 {
 				return fmt.Errorf("upload-nodes-percentage must be number between 0 and 100")
 			}
 
-			stressUpload := upload.NewUpload()
+			distribution, err := bee.ParseDistribution(c.config.GetString(optionNameDistribution))
+			if err != nil {
+				return err
+			}
+
+			slo, err := stress.ParseSLO(c.config.GetString(optionNameSLO))
+			if err != nil {
+				return fmt.Errorf("parsing slo: %w", err)
+			}
+
+			soakAgeBuckets, err := cmd.Flags().GetDurationSlice(optionNameSoakAgeBuckets)
+			if err != nil {
+				return fmt.Errorf("parsing soak age buckets: %w", err)
+			}
+
+			stressUpload := upload.NewUpload(c.ledger)
 			stressOptions := stress.Options{
 				FileSize:              round(c.config.GetFloat64(optionNameFileSize) * 1024 * 1024),
 				MetricsEnabled:        c.config.GetBool(optionNamePushMetrics),
@@ -180,8 +212,15 @@ func (c *command) initStressUpload() *cobra.Command {
 				Seed:                  seed,
 				Timeout:               c.config.GetDuration(optionNameTimeout),
 				UploadNodesPercentage: uploadNodesPercentage,
+				UploadConcurrency:     c.config.GetInt(optionNameUploadConcurrency),
 				PostageAmount:         c.config.GetInt64(optionNamePostageAmount),
 				PostageWait:           c.config.GetDuration(optionNamePostageBatchhWait),
+				SLO:                   slo,
+				Distribution:          distribution,
+				DistributionDepth:     uint8(c.config.GetUint(optionNameDistributionDepth)),
+				SoakInterval:          c.config.GetDuration(optionNameSoakInterval),
+				SoakSamples:           c.config.GetInt(optionNameSoakSamples),
+				SoakAgeBuckets:        soakAgeBuckets,
 			}
 
 			dynamicStages := []stress.Stage{}
@@ -213,10 +252,14 @@ func (c *command) initStressUpload() *cobra.Command {
 	cmd.PersistentFlags().StringVar(&additionalStorageRequest, optionNameAdditionalStorageRequest, "34Gi", "storage request")
 	cmd.Flags().Int64P(optionNameSeed, "s", 0, "seed for generating chunks; if not set, will be random")
 	cmd.PersistentFlags().IntVar(&uploadNodesPercentage, optionNameUploadNodesPercentage, 50, "percentage of nodes to upload to")
+	cmd.Flags().Int(optionNameUploadConcurrency, 100, "maximum number of nodes uploading at the same time")
 	cmd.Flags().Duration(optionNameTimeout, 5*time.Minute, "how long to upload files on each node")
 	cmd.Flags().Float64(optionNameFileSize, 1, "file size in MB")
 	cmd.Flags().Int(optionNameRetries, 5, "number of reties on problems")
 	cmd.Flags().Duration(optionNameRetryDelay, time.Second, "retry delay duration")
+	cmd.Flags().Duration(optionNameSoakInterval, 0, "interval of soak verification of previously uploaded files; if not set, soak mode is disabled")
+	cmd.Flags().Int(optionNameSoakSamples, 10, "number of previously uploaded files verified in each soak round")
+	cmd.Flags().DurationSlice(optionNameSoakAgeBuckets, []time.Duration{time.Hour, 6 * time.Hour, 24 * time.Hour, 72 * time.Hour}, "upper bounds of age buckets for soak durability report")
 	// CICD options
 	cmd.Flags().BoolVar(&clefSignerEnable, optionNameClefSignerEnable, false, "enable Clef signer")
 	cmd.Flags().Uint64Var(&dbCapacity, optionNameDBCapacity, 5000000, "DB capacity")
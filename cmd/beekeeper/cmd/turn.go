@@ -1,6 +1,13 @@
 package cmd
 
 import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/ethersphere/beekeeper/pkg/chaos"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
@@ -22,12 +29,33 @@ const (
 	optionNameJitter         = "jitter"
 	optionNameDuplicate      = "duplicate"
 	optionNameCorrupt        = "corrupt"
+	optionNamePodname2       = "podname2"
+	optionNameBackend        = "backend"
+	optionNameWorkers        = "workers"
+	optionNameCPULoad        = "cpu-load"
+	optionNameMemorySize     = "memory-size"
+	optionNameVolumePath     = "volume-path"
+	optionNamePath           = "path"
+	optionNameErrno          = "errno"
+	optionNamePercent        = "percent"
+	optionNameTimeOffset     = "time-offset"
 )
 
 func (c *command) initTurnOnCmd() (err error) {
+	c.root.AddCommand(c.initTurnCmd("turn-on", "Turn on chaos scenario on a Bee cluster", "create"))
+	return nil
+}
+
+func (c *command) initTurnUpdateCmd() (err error) {
+	c.root.AddCommand(c.initTurnCmd("turn-update", "Update running chaos scenario on a Bee cluster", "update"))
+	return nil
+}
+
+// initTurnCmd returns command that creates or updates chaos scenarios
+func (c *command) initTurnCmd(use, short, action string) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "turn-on",
-		Short: "Turn on chaos scenario on a Bee cluster",
+		Use:   use,
+		Short: short,
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			return cmd.Help()
 		},
@@ -41,23 +69,31 @@ func (c *command) initTurnOnCmd() (err error) {
 	cmd.PersistentFlags().String(optionNameMode, "one", "defines the mode to run chaos scenario [one|all|fixed|fixed-percent|random-max-percent]")
 	cmd.PersistentFlags().String(optionNameValue, "", "depends on the mode, for one and all leave empty")
 	cmd.PersistentFlags().String(optionNameDuration, "", "defines the duration for each chaos scenario [15s|5m|1h]")
-	cmd.PersistentFlags().String(optionNameCron, "", "defines the scheduler rules for the running time of the chaos @every [15s|5m|1h]")
+	cmd.PersistentFlags().String(optionNameCron, "", "defines the scheduler rules for the running time of the chaos [15s|@every 5m|@hourly|0 * * * *]")
 	cmd.PersistentFlags().String(optionNamePodname, "bee", "if not specified it will use random bee pod from the namespace")
+	cmd.PersistentFlags().String(optionNameBackend, chaos.BackendChaosMesh, "backend that runs chaos scenario [chaos-mesh|native], native supports only podfailure and podkill")
+	cmd.PersistentFlags().String(optionNamePodname2, "bee", "same as podname, target of the partition, used only for networkPartition scenario")
 	cmd.PersistentFlags().String(optionNameMode2, "one", "same as mode, used only for networkPartition scenario")
 	cmd.PersistentFlags().String(optionNameValue2, "", "same as value, used only for networkPartition scenario")
 	cmd.PersistentFlags().String(optionNameDirection, "both", "specifies the partition direction, used only for networkPartition scenario [from|to|both]")
 	cmd.PersistentFlags().String(optionNameCorrelation, "0", "correlation is used to emulate variation for networkChaos scenarios")
 	cmd.PersistentFlags().String(optionNameLoss, "", "defines the percentage of packet loss, used only for networkLoss scenario")
-	cmd.PersistentFlags().String(optionNameLatency, "", "defines the delay time in sending packets, used only for networkDelay scenario")
+	cmd.PersistentFlags().String(optionNameLatency, "", "defines the delay time in sending packets or of IO operations, used only for networkDelay and ioLatency scenarios")
 	cmd.PersistentFlags().String(optionNameJitter, "0ms", "specifies the jitter of the delay time")
 	cmd.PersistentFlags().String(optionNameDuplicate, "", "indicates the percentage of packet duplication, used only for networkDuplicate scenario")
 	cmd.PersistentFlags().String(optionNameCorrupt, "", "specifies the percentage of packet corruption, used only for networkCorrupt scenario")
+	cmd.PersistentFlags().String(optionNameWorkers, "1", "number of stress workers, used only for stress scenario")
+	cmd.PersistentFlags().String(optionNameCPULoad, "", "percentage of CPU load per worker, used only for stress scenario")
+	cmd.PersistentFlags().String(optionNameMemorySize, "", "memory allocated per worker [256MB|1GB|50%], used only for stress scenario")
+	cmd.PersistentFlags().String(optionNameVolumePath, "/home/bee/.bee", "mount path of the volume with injected IO chaos, used only for IO scenarios")
+	cmd.PersistentFlags().String(optionNamePath, "", "glob of files with injected IO chaos, all files in the volume if not set, used only for IO scenarios")
+	cmd.PersistentFlags().String(optionNameErrno, "5", "error number returned by faulty IO operations, used only for ioFault scenario")
+	cmd.PersistentFlags().String(optionNamePercent, "100", "percentage of injected IO operations, used only for IO scenarios")
+	cmd.PersistentFlags().String(optionNameTimeOffset, "", "clock skew [-10m|1h], used only for timeSkew scenario")
 
-	cmd.AddCommand(c.initTurnPodfailure("create"))
-	cmd.AddCommand(c.initTurnPodkill("create"))
+	c.addTurnScenarios(cmd, action)
 
-	c.root.AddCommand(cmd)
-	return nil
+	return cmd
 }
 
 func (c *command) initTurnOffCmd() (err error) {
@@ -77,14 +113,29 @@ func (c *command) initTurnOffCmd() (err error) {
 	cmd.PersistentFlags().String(optionNameMode, "one", "defines the mode to run chaos scenario [one|all|fixed|fixed-percent|random-max-percent]")
 	cmd.PersistentFlags().String(optionNameValue, "", "depends on the mode, for one and all leave empty")
 	cmd.PersistentFlags().String(optionNamePodname, "bee", "if not specified it will use random bee pod from the namespace")
+	cmd.PersistentFlags().String(optionNameBackend, chaos.BackendChaosMesh, "backend that runs chaos scenario [chaos-mesh|native], native supports only podfailure and podkill")
 
-	cmd.AddCommand(c.initTurnPodfailure("delete"))
-	cmd.AddCommand(c.initTurnPodkill("delete"))
+	c.addTurnScenarios(cmd, "delete")
 
 	c.root.AddCommand(cmd)
 	return nil
 }
 
+// addTurnScenarios adds subcommands of all chaos scenarios with the action
+func (c *command) addTurnScenarios(cmd *cobra.Command, action string) {
+	cmd.AddCommand(c.initTurnPodfailure(action))
+	cmd.AddCommand(c.initTurnPodkill(action))
+	cmd.AddCommand(c.initTurnNetworkPartition(action))
+	cmd.AddCommand(c.initTurnNetworkLoss(action))
+	cmd.AddCommand(c.initTurnNetworkDelay(action))
+	cmd.AddCommand(c.initTurnNetworkDuplicate(action))
+	cmd.AddCommand(c.initTurnNetworkCorrupt(action))
+	cmd.AddCommand(c.initTurnStress(action))
+	cmd.AddCommand(c.initTurnIOLatency(action))
+	cmd.AddCommand(c.initTurnIOFault(action))
+	cmd.AddCommand(c.initTurnTimeSkew(action))
+}
+
 func (c *command) turnPreRunE(cmd *cobra.Command, args []string) (err error) {
 	if err = c.config.BindPFlags(cmd.Flags()); err != nil {
 		return
@@ -92,3 +143,91 @@ func (c *command) turnPreRunE(cmd *cobra.Command, args []string) (err error) {
 
 	return
 }
+
+// turnExperiment creates, updates or deletes experiment of the scenario with
+// parameters set by the flags. Native backend runs scheduled experiments in
+// the process, so turning them on blocks until interrupt and then deletes
+// them.
+func (c *command) turnExperiment(cmd *cobra.Command, action, scenario string) (err error) {
+	e := chaos.Experiment{
+		Scenario:    scenario,
+		Mode:        c.config.GetString(optionNameMode),
+		Value:       c.config.GetString(optionNameValue),
+		Podname:     c.config.GetString(optionNamePodname),
+		Duration:    c.config.GetString(optionNameDuration),
+		Cron:        c.config.GetString(optionNameCron),
+		Mode2:       c.config.GetString(optionNameMode2),
+		Value2:      c.config.GetString(optionNameValue2),
+		Podname2:    c.config.GetString(optionNamePodname2),
+		Direction:   c.config.GetString(optionNameDirection),
+		Correlation: c.config.GetString(optionNameCorrelation),
+		Loss:        c.config.GetString(optionNameLoss),
+		Latency:     c.config.GetString(optionNameLatency),
+		Jitter:      c.config.GetString(optionNameJitter),
+		Duplicate:   c.config.GetString(optionNameDuplicate),
+		Corrupt:     c.config.GetString(optionNameCorrupt),
+		Workers:     c.config.GetString(optionNameWorkers),
+		CPULoad:     c.config.GetString(optionNameCPULoad),
+		MemorySize:  c.config.GetString(optionNameMemorySize),
+		VolumePath:  c.config.GetString(optionNameVolumePath),
+		Path:        c.config.GetString(optionNamePath),
+		Errno:       c.config.GetString(optionNameErrno),
+		Percent:     c.config.GetString(optionNamePercent),
+		TimeOffset:  c.config.GetString(optionNameTimeOffset),
+	}
+	spec, err := e.Spec()
+	if err != nil {
+		return err
+	}
+	if action != "delete" {
+		if err = spec.Validate(); err != nil {
+			return err
+		}
+	}
+
+	backendName := c.config.GetString(optionNameBackend)
+	backend, err := newChaosBackend(backendName, c.config.GetString(optionNameKubeConfig), false, c.config.GetString(optionNameChaosNamespace))
+	if err != nil {
+		return err
+	}
+
+	ctx := cmd.Context()
+	if err = backend.Check(ctx); err != nil {
+		return err
+	}
+
+	switch action {
+	case "delete":
+		if err = backend.Delete(ctx, spec); err != nil {
+			return err
+		}
+		fmt.Printf("Turned off %s\n", spec.Name())
+		return
+	case "update":
+		if err = backend.Update(ctx, spec); err != nil {
+			return err
+		}
+		fmt.Printf("Updated %s\n", spec.Name())
+	default:
+		if err = backend.Create(ctx, spec); err != nil {
+			return err
+		}
+		fmt.Printf("Turned on %s\n", spec.Name())
+	}
+
+	if backendName != chaos.BackendNative || e.Cron == "" {
+		return
+	}
+
+	fmt.Printf("running %s at %s, interrupt to turn it off\n", spec.Name(), e.Cron)
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(signals)
+	<-signals
+
+	if err = backend.Delete(context.Background(), spec); err != nil {
+		return err
+	}
+	fmt.Printf("Turned off %s\n", spec.Name())
+	return
+}
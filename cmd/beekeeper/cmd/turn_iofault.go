@@ -0,0 +1,19 @@
+package cmd
+
+import (
+	"github.com/ethersphere/beekeeper/pkg/chaos"
+
+	"github.com/spf13/cobra"
+)
+
+func (c *command) initTurnIOFault(action string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "iofault",
+		Short: "iofault scenario",
+		Long:  `iofault scenario.`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			return c.turnExperiment(cmd, action, chaos.ScenarioIOFault)
+		},
+		PreRunE: c.turnPreRunE,
+	}
+}
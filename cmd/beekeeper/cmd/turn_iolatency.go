@@ -0,0 +1,19 @@
+package cmd
+
+import (
+	"github.com/ethersphere/beekeeper/pkg/chaos"
+
+	"github.com/spf13/cobra"
+)
+
+func (c *command) initTurnIOLatency(action string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "iolatency",
+		Short: "iolatency scenario",
+		Long:  `iolatency scenario.`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			return c.turnExperiment(cmd, action, chaos.ScenarioIOLatency)
+		},
+		PreRunE: c.turnPreRunE,
+	}
+}
@@ -0,0 +1,19 @@
+package cmd
+
+import (
+	"github.com/ethersphere/beekeeper/pkg/chaos"
+
+	"github.com/spf13/cobra"
+)
+
+func (c *command) initTurnNetworkCorrupt(action string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "networkcorrupt",
+		Short: "networkcorrupt scenario",
+		Long:  `networkcorrupt scenario.`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			return c.turnExperiment(cmd, action, chaos.ScenarioNetworkCorrupt)
+		},
+		PreRunE: c.turnPreRunE,
+	}
+}
@@ -0,0 +1,19 @@
+package cmd
+
+import (
+	"github.com/ethersphere/beekeeper/pkg/chaos"
+
+	"github.com/spf13/cobra"
+)
+
+func (c *command) initTurnNetworkDelay(action string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "networkdelay",
+		Short: "networkdelay scenario",
+		Long:  `networkdelay scenario.`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			return c.turnExperiment(cmd, action, chaos.ScenarioNetworkDelay)
+		},
+		PreRunE: c.turnPreRunE,
+	}
+}
@@ -0,0 +1,19 @@
+package cmd
+
+import (
+	"github.com/ethersphere/beekeeper/pkg/chaos"
+
+	"github.com/spf13/cobra"
+)
+
+func (c *command) initTurnNetworkDuplicate(action string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "networkduplicate",
+		Short: "networkduplicate scenario",
+		Long:  `networkduplicate scenario.`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			return c.turnExperiment(cmd, action, chaos.ScenarioNetworkDuplicate)
+		},
+		PreRunE: c.turnPreRunE,
+	}
+}
@@ -0,0 +1,19 @@
+package cmd
+
+import (
+	"github.com/ethersphere/beekeeper/pkg/chaos"
+
+	"github.com/spf13/cobra"
+)
+
+func (c *command) initTurnNetworkLoss(action string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "networkloss",
+		Short: "networkloss scenario",
+		Long:  `networkloss scenario.`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			return c.turnExperiment(cmd, action, chaos.ScenarioNetworkLoss)
+		},
+		PreRunE: c.turnPreRunE,
+	}
+}
@@ -0,0 +1,19 @@
+package cmd
+
+import (
+	"github.com/ethersphere/beekeeper/pkg/chaos"
+
+	"github.com/spf13/cobra"
+)
+
+func (c *command) initTurnNetworkPartition(action string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "networkpartition",
+		Short: "networkpartition scenario",
+		Long:  `networkpartition scenario.`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			return c.turnExperiment(cmd, action, chaos.ScenarioNetworkPartition)
+		},
+		PreRunE: c.turnPreRunE,
+	}
+}
@@ -1,8 +1,6 @@
 package cmd
 
 import (
-	"fmt"
-
 	"github.com/ethersphere/beekeeper/pkg/chaos"
 
 	"github.com/spf13/cobra"
@@ -14,29 +12,7 @@ func (c *command) initTurnPodkill(action string) *cobra.Command {
 		Short: "podkill scenario",
 		Long:  `podkill scenario.`,
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
-			kubeconfig := c.config.GetString(optionNameKubeConfig)
-			mode := c.config.GetString(optionNameMode)
-			value := c.config.GetString(optionNameValue)
-			namespace := c.config.GetString(optionNameChaosNamespace)
-			podname := c.config.GetString(optionNamePodname)
-			cron := c.config.GetString(optionNameCron)
-
-			ctx := cmd.Context()
-			err = chaos.CheckChaosMesh(ctx, kubeconfig, namespace)
-			if err != nil {
-				return err
-			}
-
-			err = chaos.PodKill(ctx, kubeconfig, action, mode, value, namespace, podname, cron)
-			if err != nil {
-				return err
-			}
-			if action == "create" {
-				fmt.Printf("Turned on pod-kill-%s-%s\n", mode, podname)
-			} else {
-				fmt.Printf("Turned off pod-kill-%s-%s\n", mode, podname)
-			}
-			return
+			return c.turnExperiment(cmd, action, chaos.ScenarioPodKill)
 		},
 		PreRunE: c.turnPreRunE,
 	}
@@ -0,0 +1,19 @@
+package cmd
+
+import (
+	"github.com/ethersphere/beekeeper/pkg/chaos"
+
+	"github.com/spf13/cobra"
+)
+
+func (c *command) initTurnStress(action string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "stress",
+		Short: "stress scenario",
+		Long:  `stress scenario.`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			return c.turnExperiment(cmd, action, chaos.ScenarioStress)
+		},
+		PreRunE: c.turnPreRunE,
+	}
+}
@@ -0,0 +1,19 @@
+package cmd
+
+import (
+	"github.com/ethersphere/beekeeper/pkg/chaos"
+
+	"github.com/spf13/cobra"
+)
+
+func (c *command) initTurnTimeSkew(action string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "timeskew",
+		Short: "timeskew scenario",
+		Long:  `timeskew scenario.`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			return c.turnExperiment(cmd, action, chaos.ScenarioTimeSkew)
+		},
+		PreRunE: c.turnPreRunE,
+	}
+}
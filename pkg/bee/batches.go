@@ -0,0 +1,280 @@
+package bee
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/ethersphere/beekeeper/pkg/beeclient/api"
+)
+
+const (
+	// batchPollInterval is the interval between checks whether a new batch is usable
+	batchPollInterval = time.Second
+	// DefaultBucketDepth is the bucket depth of postage batches assumed until
+	// the node reports it
+	DefaultBucketDepth = 16
+	// bucketOverflowRisk is the accepted expected number of full buckets when
+	// a batch is used up to its estimated capacity
+	bucketOverflowRisk = 0.01
+)
+
+// ErrBatchNotUsable is returned when a batch does not become usable in time
+var ErrBatchNotUsable = errors.New("batch not usable")
+
+// BatchOptions represents options for getting a postage batch from the node's
+// batch manager
+type BatchOptions struct {
+	Amount int64
+	Depth  uint64 // minimum depth, it is increased if chunks do not fit
+	Label  string
+	Chunks int64         // number of chunks that will be stamped with the batch
+	Prefix uint8         // number of leading address bits shared by the chunks, 0 if they are spread evenly
+	Wait   time.Duration // maximum duration to wait for a new batch to become usable
+}
+
+// BatchManager keeps track of node's postage batches by label and depth and
+// estimates their remaining capacity from the number of stamped chunks
+type BatchManager struct {
+	client *Client
+
+	mu          sync.Mutex
+	batches     map[batchKey][]*managedBatch
+	pending     map[batchKey]chan struct{} // closed when batch creation finishes
+	bucketDepth uint8                      // reported by the node, default until known
+}
+
+type batchKey struct {
+	label  string
+	depth  uint64
+	prefix uint8
+}
+
+type managedBatch struct {
+	id   string
+	used int64 // estimated number of stamped chunks
+}
+
+// newBatchManager returns new batch manager of the node
+func newBatchManager(c *Client) *BatchManager {
+	return &BatchManager{
+		client:      c,
+		batches:     make(map[batchKey][]*managedBatch),
+		pending:     make(map[batchKey]chan struct{}),
+		bucketDepth: DefaultBucketDepth,
+	}
+}
+
+// Get returns ID of a usable batch with a given label and depth that has
+// enough estimated capacity for the number of chunks, creating a new batch
+// when existing ones are exhausted. Depth is increased to the smallest one
+// whose capacity fits the chunks.
+func (m *BatchManager) Get(ctx context.Context, o BatchOptions) (string, error) {
+	if o.Depth < MinimumBatchDepth {
+		o.Depth = MinimumBatchDepth
+	}
+
+	for {
+		stamps, err := m.stamps(ctx)
+		if err != nil {
+			return "", err
+		}
+
+		m.mu.Lock()
+		// all batches of the node have the same bucket depth
+		for _, s := range stamps {
+			m.bucketDepth = s.BucketDepth
+			break
+		}
+		if d := BatchDepth(o.Chunks, m.bucketDepth, o.Prefix); o.Depth < d {
+			o.Depth = d
+		}
+		key := batchKey{label: o.Label, depth: o.Depth, prefix: o.Prefix}
+
+		if _, ok := m.batches[key]; !ok {
+			m.adopt(key, stamps)
+		}
+
+		for _, b := range m.batches[key] {
+			s, ok := stamps[b.id]
+			if !ok || !s.Usable || exhausted(s) {
+				continue
+			}
+			if b.used+o.Chunks > batchCapacity(o.Depth, s.BucketDepth, o.Prefix) {
+				continue
+			}
+
+			b.used += o.Chunks
+			m.mu.Unlock()
+			return b.id, nil
+		}
+
+		// another caller is already creating a batch, wait for it and retry
+		if pending, ok := m.pending[key]; ok {
+			m.mu.Unlock()
+			select {
+			case <-pending:
+				continue
+			case <-ctx.Done():
+				return "", ctx.Err()
+			}
+		}
+
+		pending := make(chan struct{})
+		m.pending[key] = pending
+		m.mu.Unlock()
+
+		id, err := m.create(ctx, o)
+
+		m.mu.Lock()
+		delete(m.pending, key)
+		close(pending)
+		if err == nil {
+			m.batches[key] = append(m.batches[key], &managedBatch{id: id, used: o.Chunks})
+		}
+		m.mu.Unlock()
+
+		return id, err
+	}
+}
+
+// create creates a new batch and waits for it to become usable
+func (m *BatchManager) create(ctx context.Context, o BatchOptions) (string, error) {
+	id, err := m.client.CreatePostageBatch(ctx, o.Amount, o.Depth, o.Label)
+	if err != nil {
+		return "", fmt.Errorf("create batch: %w", err)
+	}
+
+	if err := m.client.WaitPostageBatch(ctx, id, o.Wait); err != nil {
+		return "", err
+	}
+
+	return id, nil
+}
+
+// adopt starts tracking node's existing usable batches with a given label and
+// depth, estimating their used capacity from the reported utilization
+func (m *BatchManager) adopt(key batchKey, stamps map[string]api.PostageStampResponse) {
+	m.batches[key] = nil
+	for id, s := range stamps {
+		if !s.Usable || s.Label != key.label || uint64(s.Depth) != key.depth {
+			continue
+		}
+
+		m.batches[key] = append(m.batches[key], &managedBatch{
+			id:   id,
+			used: int64(s.Utilization) << s.BucketDepth,
+		})
+	}
+}
+
+// stamps returns node's batches by ID
+func (m *BatchManager) stamps(ctx context.Context) (map[string]api.PostageStampResponse, error) {
+	batches, err := m.client.PostageBatches(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get batches: %w", err)
+	}
+
+	stamps := make(map[string]api.PostageStampResponse, len(batches))
+	for _, b := range batches {
+		stamps[b.BatchID] = b
+	}
+
+	return stamps, nil
+}
+
+// BatchDepth returns the smallest depth of a batch with a given bucket depth
+// that can stamp a given number of chunks sharing a given address prefix
+// length; it is always deeper than buckets
+func BatchDepth(chunks int64, bucketDepth, prefix uint8) uint64 {
+	depth := uint64(MinimumBatchDepth)
+	for depth <= uint64(bucketDepth) || batchCapacity(depth, bucketDepth, prefix) < chunks {
+		depth++
+	}
+	return depth
+}
+
+// batchCapacity returns the estimated number of chunks that can be stamped
+// with a batch before any of its buckets gets full. Chunks sharing an address
+// prefix fall only into 2^(bucketDepth-prefix) buckets, evenly spread in
+// them. A bucket has 2^(depth-bucketDepth) slots and the number of chunks in
+// a bucket is approximately Poisson distributed, so the capacity is the
+// largest number of chunks for which the expected number of full buckets
+// stays below bucketOverflowRisk.
+func batchCapacity(depth uint64, bucketDepth, prefix uint8) int64 {
+	if depth <= uint64(bucketDepth) {
+		return 0
+	}
+	slots := int64(1) << (depth - uint64(bucketDepth))
+	if prefix >= bucketDepth {
+		return slots
+	}
+	used := bucketDepth - prefix
+	buckets := float64(uint64(1) << used)
+
+	lo, hi := int64(0), slots<<used
+	for lo < hi {
+		n := (lo + hi + 1) / 2
+		if buckets*poissonTail(float64(n)/buckets, slots) <= bucketOverflowRisk {
+			lo = n
+		} else {
+			hi = n - 1
+		}
+	}
+	return lo
+}
+
+// poissonTail returns probability that Poisson distributed variable with
+// a given mean is greater than k
+func poissonTail(mean float64, k int64) (p float64) {
+	if mean == 0 {
+		return 0
+	}
+	for i := k + 1; ; i++ {
+		lg, _ := math.Lgamma(float64(i) + 1)
+		term := math.Exp(float64(i)*math.Log(mean) - mean - lg)
+		p += term
+		if float64(i) > mean && term <= p*1e-12 {
+			return p
+		}
+	}
+}
+
+// exhausted returns true if the fullest bucket of the batch, as reported by
+// the node, is full
+func exhausted(s api.PostageStampResponse) bool {
+	if uint64(s.Depth) <= uint64(s.BucketDepth) {
+		return true
+	}
+	return int64(s.Utilization) >= int64(1)<<(uint64(s.Depth)-uint64(s.BucketDepth))
+}
+
+// WaitPostageBatch waits until the batch is usable on the node, at most for
+// a given duration
+func (c *Client) WaitPostageBatch(ctx context.Context, batchID string, wait time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, wait)
+	defer cancel()
+
+	for {
+		batches, err := c.PostageBatches(ctx)
+		if err == nil {
+			for _, b := range batches {
+				if b.BatchID == batchID && b.Usable {
+					return nil
+				}
+			}
+		}
+
+		select {
+		case <-time.After(batchPollInterval):
+		case <-ctx.Done():
+			if err != nil {
+				return fmt.Errorf("%w: batch %s: %v", ErrBatchNotUsable, batchID, err)
+			}
+			return fmt.Errorf("%w: batch %s within %s", ErrBatchNotUsable, batchID, wait)
+		}
+	}
+}
@@ -7,7 +7,6 @@ import (
 	"hash"
 	"math/rand"
 
-	"github.com/ethersphere/bee/pkg/cac"
 	"github.com/ethersphere/bee/pkg/swarm"
 	bmtlegacy "github.com/ethersphere/bmt/legacy"
 	"golang.org/x/crypto/sha3"
@@ -43,6 +42,15 @@ func NewRandomChunk(r *rand.Rand) (Chunk, error) {
 	return c, err
 }
 
+// NewChunk returns Bee chunk of a content addressed chunk
+func NewChunk(ch swarm.Chunk) Chunk {
+	return Chunk{
+		address: ch.Address(),
+		data:    ch.Data(),
+		span:    len(ch.Data()) - spanInfoSize,
+	}
+}
+
 // Address returns chunk's address
 func (c *Chunk) Address() swarm.Address {
 	return c.address
@@ -149,15 +157,3 @@ next:
 func chunkHahser() hash.Hash {
 	return sha3.NewLegacyKeccak256()
 }
-
-// GenerateRandomChunkAt generates a chunk with address of proximity order po wrt target.
-func GenerateRandomChunkAt(rnd *rand.Rand, target swarm.Address, po uint8) swarm.Chunk {
-	data := make([]byte, swarm.ChunkSize)
-	for {
-		_, _ = rnd.Read(data)
-		chunk, _ := cac.New(data)
-		if swarm.Proximity(chunk.Address().Bytes(), target.Bytes()) == po {
-			return chunk
-		}
-	}
-}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"crypto/tls"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -16,6 +17,7 @@ import (
 	"github.com/ethersphere/bee/pkg/swarm"
 	"github.com/ethersphere/beekeeper/pkg/beeclient/api"
 	"github.com/ethersphere/beekeeper/pkg/beeclient/debugapi"
+	"github.com/ethersphere/beekeeper/pkg/ledger"
 )
 
 const retryCount int = 5
@@ -26,6 +28,8 @@ type Client struct {
 	debug *debugapi.Client
 	opts  ClientOptions
 
+	batches *BatchManager
+
 	// number of times to retry call
 	retry int
 }
@@ -37,6 +41,10 @@ type ClientOptions struct {
 	DebugAPIURL         *url.URL
 	DebugAPIInsecureTLS bool
 	Retry               int
+	// Name is the name of the node, it is recorded in the ledger
+	Name string
+	// Ledger records uploaded content, if set
+	Ledger *ledger.Ledger
 }
 
 // NewClient returns Bee client
@@ -59,6 +67,7 @@ func NewClient(opts ClientOptions) (c *Client) {
 	if opts.Retry > 0 {
 		c.retry = opts.Retry
 	}
+	c.batches = newBatchManager(c)
 
 	return
 }
@@ -159,7 +168,9 @@ func (c *Client) DownloadFile(ctx context.Context, a swarm.Address) (size int64,
 	if err != nil {
 		return 0, nil, fmt.Errorf("download file %s: %w", a, err)
 	}
+	defer r.Close()
 
+	// hash data while it is streamed, without buffering it
 	h := fileHasher()
 	size, err = io.Copy(h, r)
 	if err != nil {
@@ -208,6 +219,39 @@ func (c *Client) Peers(ctx context.Context) (peers []swarm.Address, err error) {
 	return
 }
 
+// Blocklist returns node's blocklisted peers
+func (c *Client) Blocklist(ctx context.Context) (peers []swarm.Address, err error) {
+	ps, err := c.debug.Node.Blocklist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get blocklist: %w", err)
+	}
+
+	for _, p := range ps.Peers {
+		peers = append(peers, p.Address)
+	}
+
+	return
+}
+
+// Connect connects node to a peer with a given multiaddress
+func (c *Client) Connect(ctx context.Context, multiaddr string) (swarm.Address, error) {
+	r, err := c.debug.Node.Connect(ctx, multiaddr)
+	if err != nil {
+		return swarm.ZeroAddress, fmt.Errorf("connect to %s: %w", multiaddr, err)
+	}
+
+	return r.Address, nil
+}
+
+// Disconnect disconnects node from a given peer
+func (c *Client) Disconnect(ctx context.Context, a swarm.Address) error {
+	if err := c.debug.Node.Disconnect(ctx, a); err != nil {
+		return fmt.Errorf("disconnect from %s: %w", a, err)
+	}
+
+	return nil
+}
+
 // PinRootHash pins root hash of given reference.
 func (c *Client) PinRootHash(ctx context.Context, ref swarm.Address) error {
 	return c.api.Pinning.PinRootHash(ctx, ref)
@@ -308,21 +352,9 @@ func (c *Client) CreatePostageBatch(ctx context.Context, amount int64, depth uin
 	return c.api.Postage.CreatePostageBatch(ctx, amount, depth, label)
 }
 
-func (c *Client) GetOrCreateBatch(ctx context.Context, depth uint64, sleep time.Duration) (string, error) {
-	batches, err := c.PostageBatches(ctx)
-	if err != nil {
-		return "", err
-	}
-
-	fmt.Println("got batches", batches)
-
-	if len(batches) != 0 {
-		return batches[0].BatchID, nil
-	}
-
-	b, err := c.CreatePostageBatch(ctx, 1, depth, "test-label")
-	time.Sleep(sleep)
-	return b, err
+// Batches returns node's postage batch manager
+func (c *Client) Batches() *BatchManager {
+	return c.batches
 }
 
 // PostageBatches returns the list of batches of node
@@ -330,6 +362,17 @@ func (c *Client) PostageBatches(ctx context.Context) ([]api.PostageStampResponse
 	return c.api.Postage.PostageBatches(ctx)
 }
 
+// PostageBatch returns node's batch with a given ID
+func (c *Client) PostageBatch(ctx context.Context, batchID string) (api.PostageStampResponse, error) {
+	return c.api.Postage.PostageBatch(ctx, batchID)
+}
+
+// GlobalPostageBatches returns all postage batches known by the node,
+// including batches owned by other nodes
+func (c *Client) GlobalPostageBatches(ctx context.Context) ([]debugapi.Batch, error) {
+	return c.debug.Postage.Batches(ctx)
+}
+
 // ReserveState returns reserve radius, available capacity, inner and outer radiuses
 func (c *Client) ReserveState(ctx context.Context) (debugapi.ReserveState, error) {
 	return c.debug.Postage.Reservestate(ctx)
@@ -342,11 +385,24 @@ func (c *Client) SendPSSMessage(ctx context.Context, nodeAddress swarm.Address,
 
 // UploadSOC uploads a single owner chunk to a node with a E
 func (c *Client) UploadSOC(ctx context.Context, owner, ID, signature string, data []byte, batchID string) (swarm.Address, error) {
+	// soc is recorded as it is downloaded, id and signature followed by data
+	id, err := hex.DecodeString(ID)
+	if err != nil {
+		return swarm.ZeroAddress, fmt.Errorf("decode soc id: %w", err)
+	}
+	sig, err := hex.DecodeString(signature)
+	if err != nil {
+		return swarm.ZeroAddress, fmt.Errorf("decode soc signature: %w", err)
+	}
+
 	resp, err := c.api.SOC.UploadSOC(ctx, owner, ID, signature, bytes.NewReader(data), batchID)
 	if err != nil {
 		return swarm.ZeroAddress, err
 	}
 
+	chunk := append(append(id, sig...), data...)
+	c.record(ctx, ledger.KindChunk, resp.Reference, "", int64(len(chunk)), dataHash(chunk), api.UploadOptions{BatchID: batchID})
+
 	return resp.Reference, nil
 }
 
@@ -451,6 +507,80 @@ func (c *Client) ChequebookBalance(ctx context.Context) (resp ChequebookBalanceR
 	}, nil
 }
 
+// ChequebookDeposit deposits amount from node's wallet to its chequebook and
+// returns transaction hash
+func (c *Client) ChequebookDeposit(ctx context.Context, amount int64) (string, error) {
+	r, err := c.debug.Node.ChequebookDeposit(ctx, amount)
+	if err != nil {
+		return "", fmt.Errorf("chequebook deposit: %w", err)
+	}
+
+	return r.TransactionHash, nil
+}
+
+// ChequebookWithdraw withdraws amount from node's chequebook to its wallet and
+// returns transaction hash
+func (c *Client) ChequebookWithdraw(ctx context.Context, amount int64) (string, error) {
+	r, err := c.debug.Node.ChequebookWithdraw(ctx, amount)
+	if err != nil {
+		return "", fmt.Errorf("chequebook withdraw: %w", err)
+	}
+
+	return r.TransactionHash, nil
+}
+
+// PeerCheques represents last cheques sent to and received from a peer
+type PeerCheques struct {
+	Peer         string
+	LastReceived *Cheque
+	LastSent     *Cheque
+}
+
+// ChequebookCheque returns last cheques sent to and received from a given peer
+func (c *Client) ChequebookCheque(ctx context.Context, a swarm.Address) (resp PeerCheques, err error) {
+	r, err := c.debug.Node.ChequebookCheque(ctx, a)
+	if err != nil {
+		return PeerCheques{}, fmt.Errorf("get cheques with node %s: %w", a, err)
+	}
+
+	return peerCheques(r), nil
+}
+
+// ChequebookCheques returns last cheques sent to and received from all peers
+func (c *Client) ChequebookCheques(ctx context.Context) (resp []PeerCheques, err error) {
+	r, err := c.debug.Node.ChequebookCheques(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get cheques: %w", err)
+	}
+
+	for _, pc := range r.LastCheques {
+		resp = append(resp, peerCheques(pc))
+	}
+
+	return
+}
+
+// peerCheques converts debug API's peer cheques
+func peerCheques(r debugapi.ChequebookPeerCheques) (pc PeerCheques) {
+	pc.Peer = r.Peer
+	if r.LastReceived != nil {
+		pc.LastReceived = &Cheque{
+			Beneficiary: r.LastReceived.Beneficiary,
+			Chequebook:  r.LastReceived.Chequebook,
+			Payout:      r.LastReceived.Payout,
+		}
+	}
+	if r.LastSent != nil {
+		pc.LastSent = &Cheque{
+			Beneficiary: r.LastSent.Beneficiary,
+			Chequebook:  r.LastSent.Chequebook,
+			Payout:      r.LastSent.Payout,
+		}
+	}
+
+	return
+}
+
 // Topology represents Kademlia topology
 type Topology struct {
 	Overlay        swarm.Address
@@ -543,6 +673,8 @@ func (c *Client) UploadBytes(ctx context.Context, b []byte, o api.UploadOptions)
 		return swarm.ZeroAddress, fmt.Errorf("upload bytes: %w", err)
 	}
 
+	c.record(ctx, ledger.KindBytes, r.Reference, "", int64(len(b)), dataHash(b), o)
+
 	return r.Reference, nil
 }
 
@@ -553,6 +685,8 @@ func (c *Client) UploadChunk(ctx context.Context, data []byte, o api.UploadOptio
 		return swarm.ZeroAddress, fmt.Errorf("upload chunk: %w", err)
 	}
 
+	c.record(ctx, ledger.KindChunk, resp.Reference, "", int64(len(data)), dataHash(data), o)
+
 	return resp.Reference, nil
 }
 
@@ -567,7 +701,70 @@ func (c *Client) UploadFile(ctx context.Context, f *File, o api.UploadOptions) (
 	f.address = r.Reference
 	f.hash = h.Sum(nil)
 
-	return
+	c.record(ctx, ledger.KindFile, f.address, "", f.size, f.hash, o)
+
+	return nil
+}
+
+type noLedgerKey struct{}
+
+// WithoutLedger returns context in which uploads are not recorded in the
+// ledger, it is used for content that is expected to be garbage collected
+func WithoutLedger(ctx context.Context) context.Context {
+	return context.WithValue(ctx, noLedgerKey{}, true)
+}
+
+// record records uploaded content in the ledger, unless ctx opts out of it.
+// Failure to record is only logged, as the upload itself succeeded and
+// returning an error would make callers upload the content again.
+func (c *Client) record(ctx context.Context, kind string, ref swarm.Address, path string, size int64, hash []byte, o api.UploadOptions) {
+	if skip, _ := ctx.Value(noLedgerKey{}).(bool); skip {
+		return
+	}
+
+	if err := c.opts.Ledger.Add(ledger.Entry{
+		Reference: ref,
+		Kind:      kind,
+		Path:      path,
+		Size:      size,
+		Hash:      hash,
+		Node:      c.opts.Name,
+		BatchID:   o.BatchID,
+	}); err != nil {
+		fmt.Printf("error: node %s: record %s %s: %v\n", c.opts.Name, kind, ref, err)
+	}
+}
+
+// DownloadLedgerEntry downloads content recorded in the ledger, in the same
+// way as it was uploaded, and returns its size and hash
+func (c *Client) DownloadLedgerEntry(ctx context.Context, e ledger.Entry) (size int64, hash []byte, err error) {
+	switch e.Kind {
+	case ledger.KindFile:
+		return c.DownloadFile(ctx, e.Reference)
+	case ledger.KindBytes:
+		data, err := c.DownloadBytes(ctx, e.Reference)
+		if err != nil {
+			return 0, nil, err
+		}
+		return int64(len(data)), dataHash(data), nil
+	case ledger.KindChunk:
+		data, err := c.DownloadChunk(ctx, e.Reference, "")
+		if err != nil {
+			return 0, nil, err
+		}
+		return int64(len(data)), dataHash(data), nil
+	case ledger.KindCollection:
+		return c.DownloadManifestFile(ctx, e.Reference, e.Path)
+	default:
+		return 0, nil, fmt.Errorf("ledger entry %s: unknown kind %q", e.Reference, e.Kind)
+	}
+}
+
+// dataHash returns hash of data, as it is calculated for files
+func dataHash(data []byte) []byte {
+	h := fileHasher()
+	_, _ = h.Write(data)
+	return h.Sum(nil)
 }
 
 // UploadCollection uploads TAR collection bytes to the node
@@ -581,6 +778,10 @@ func (c *Client) UploadCollection(ctx context.Context, f *File, o api.UploadOpti
 	f.address = r.Reference
 	f.hash = h.Sum(nil)
 
+	for _, cf := range f.collection {
+		c.record(ctx, ledger.KindCollection, f.address, cf.name, cf.size, cf.hash, o)
+	}
+
 	return
 }
 
@@ -590,6 +791,7 @@ func (c *Client) DownloadManifestFile(ctx context.Context, a swarm.Address, path
 	if err != nil {
 		return 0, nil, fmt.Errorf("download manifest file %s: %w", path, err)
 	}
+	defer r.Close()
 
 	h := fileHasher()
 	size, err = io.Copy(h, r)
@@ -600,6 +802,24 @@ func (c *Client) DownloadManifestFile(ctx context.Context, a swarm.Address, path
 	return size, h.Sum(nil), nil
 }
 
+// DownloadManifestFileWithContentType downloads manifest file from the node
+// and returns it's size, hash and content type
+func (c *Client) DownloadManifestFileWithContentType(ctx context.Context, a swarm.Address, path string) (size int64, hash []byte, contentType string, err error) {
+	r, h, err := c.api.Dirs.DownloadWithHeader(ctx, a, path)
+	if err != nil {
+		return 0, nil, "", fmt.Errorf("download manifest file %s: %w", path, err)
+	}
+	defer r.Close()
+
+	hr := fileHasher()
+	size, err = io.Copy(hr, r)
+	if err != nil {
+		return 0, nil, "", fmt.Errorf("download manifest file %s: %w", path, err)
+	}
+
+	return size, hr.Sum(nil), h.Get("Content-Type"), nil
+}
+
 // CreateTag creates tag on the node
 func (c *Client) CreateTag(ctx context.Context) (resp api.TagResponse, err error) {
 	resp, err = c.api.Tags.CreateTag(ctx)
@@ -619,3 +839,52 @@ func (c *Client) GetTag(ctx context.Context, tagUID uint32) (resp api.TagRespons
 
 	return
 }
+
+// ListTags lists tags on the node
+func (c *Client) ListTags(ctx context.Context, offset, limit int) (resp []api.TagResponse, err error) {
+	r, err := c.api.Tags.ListTags(ctx, offset, limit)
+	if err != nil {
+		return nil, fmt.Errorf("list tags: %w", err)
+	}
+
+	return r.Tags, nil
+}
+
+// DeleteTag deletes tag from the node
+func (c *Client) DeleteTag(ctx context.Context, tagUID uint32) (err error) {
+	if err = c.api.Tags.DeleteTag(ctx, tagUID); err != nil {
+		return fmt.Errorf("delete tag: %w", err)
+	}
+
+	return
+}
+
+// CreateFeedManifest creates feed manifest on the node
+func (c *Client) CreateFeedManifest(ctx context.Context, owner, topic, batchID string) (swarm.Address, error) {
+	resp, err := c.api.Feeds.CreateFeedManifest(ctx, owner, topic, batchID)
+	if err != nil {
+		return swarm.ZeroAddress, fmt.Errorf("create feed manifest: %w", err)
+	}
+
+	return resp.Reference, nil
+}
+
+// FindFeedUpdate finds the latest feed update known to the node
+func (c *Client) FindFeedUpdate(ctx context.Context, owner, topic string) (resp api.FeedUpdateResponse, err error) {
+	resp, err = c.api.Feeds.FindFeedUpdate(ctx, owner, topic)
+	if err != nil {
+		return resp, fmt.Errorf("find feed update: %w", err)
+	}
+
+	return
+}
+
+// UploadFeedUpdate uploads feed update to the node
+func (c *Client) UploadFeedUpdate(ctx context.Context, owner string, u FeedUpdate, batchID string) (swarm.Address, error) {
+	ref, err := c.UploadSOC(ctx, owner, hex.EncodeToString(u.ID), hex.EncodeToString(u.Signature), u.Data, batchID)
+	if err != nil {
+		return swarm.ZeroAddress, fmt.Errorf("upload feed update %d: %w", u.Index, err)
+	}
+
+	return ref, nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/ethersphere/beekeeper/pkg/k8s"
 	k8sBee "github.com/ethersphere/beekeeper/pkg/k8s/bee"
 	"github.com/ethersphere/beekeeper/pkg/k8s/notset"
+	"github.com/ethersphere/beekeeper/pkg/ledger"
 )
 
 // Cluster represents cluster of Bee nodes
@@ -24,9 +25,11 @@ type Cluster struct {
 	debugAPIInsecureTLS bool
 	debugAPIScheme      string
 	k8s                 *k8s.Client
+	k8sBee              k8s.Bee // manages nodes in the k8s cluster, if set
 	labels              map[string]string
 	namespace           string
 	disableNamespace    bool                  // do not use namespace for node hostnames
+	ledger              *ledger.Ledger        // records uploaded content, if set
 	nodeGroups          map[string]*NodeGroup // set when groups are added to the cluster
 }
 
@@ -40,9 +43,11 @@ type ClusterOptions struct {
 	DebugAPIInsecureTLS bool
 	DebugAPIScheme      string
 	K8SClient           *k8s.Client
+	K8SBee              k8s.Bee
 	Labels              map[string]string
 	Namespace           string
 	DisableNamespace    bool
+	Ledger              *ledger.Ledger
 }
 
 // NewCluster returns new cluster
@@ -57,9 +62,11 @@ func NewCluster(name string, o ClusterOptions) *Cluster {
 		debugAPIInsecureTLS: o.DebugAPIInsecureTLS,
 		debugAPIScheme:      o.DebugAPIScheme,
 		k8s:                 o.K8SClient,
+		k8sBee:              o.K8SBee,
 		labels:              o.Labels,
 		namespace:           o.Namespace,
 		disableNamespace:    o.DisableNamespace,
+		ledger:              o.Ledger,
 
 		nodeGroups: make(map[string]*NodeGroup),
 	}
@@ -70,7 +77,9 @@ func (c *Cluster) AddNodeGroup(name string, o NodeGroupOptions) {
 	g := NewNodeGroup(name, o)
 	g.cluster = c
 
-	if g.cluster.k8s != nil {
+	if g.cluster.k8sBee != nil {
+		g.k8s = g.cluster.k8sBee
+	} else if g.cluster.k8s != nil {
 		g.k8s = k8sBee.NewClient(g.cluster.k8s)
 	} else {
 		g.k8s = new(notset.BeeClient)
@@ -0,0 +1,360 @@
+package bee
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+
+	"github.com/ethersphere/bee/pkg/cac"
+	"github.com/ethersphere/bee/pkg/crypto"
+	"github.com/ethersphere/bee/pkg/soc"
+	"github.com/ethersphere/bee/pkg/swarm"
+	"github.com/ethersphere/beekeeper/pkg/random"
+)
+
+// MaxMinedFileSize is the maximum size of generated files whose chunks follow
+// a distribution other than uniform, their data is mined chunk by chunk and
+// held in memory
+const MaxMinedFileSize = 32 * 1024 * 1024
+
+// Distribution represents how addresses of generated chunks are distributed
+type Distribution int
+
+const (
+	// DistributionUniform places chunks uniformly in the address space
+	DistributionUniform Distribution = iota
+	// DistributionNeighbourhood places all chunks in the neighbourhood of
+	// depth Depth of the first target
+	DistributionNeighbourhood
+	// DistributionBins places chunks evenly across bins 0 to Depth of the
+	// first target
+	DistributionBins
+	// DistributionZipf places chunks in neighbourhoods of depth Depth of
+	// targets chosen by Zipf distribution, the first target being the most
+	// popular
+	DistributionZipf
+	// DistributionTargets places chunks in neighbourhoods of depth Depth of
+	// targets in round robin
+	DistributionTargets
+	// DistributionProximity places chunks exactly in bin Depth of the first
+	// target
+	DistributionProximity
+)
+
+var distributionNames = map[Distribution]string{
+	DistributionUniform:       "uniform",
+	DistributionNeighbourhood: "neighbourhood",
+	DistributionBins:          "bins",
+	DistributionZipf:          "zipf",
+	DistributionTargets:       "targets",
+	DistributionProximity:     "proximity",
+}
+
+func (d Distribution) String() string {
+	if s, ok := distributionNames[d]; ok {
+		return s
+	}
+	return fmt.Sprintf("distribution(%d)", int(d))
+}
+
+// ParseDistribution returns distribution with a given name
+func ParseDistribution(s string) (Distribution, error) {
+	for d, name := range distributionNames {
+		if name == s {
+			return d, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown distribution %q", s)
+}
+
+// DatasetOptions represents dataset generator options
+type DatasetOptions struct {
+	Seed         int64
+	Distribution Distribution
+	Targets      []swarm.Address // overlays chunks are placed relative to
+	Depth        uint8           // neighbourhood depth or the deepest bin
+	PayloadSize  int             // size of chunk payload, smaller payloads are mined faster
+	ZipfS        float64         // Zipf exponent, must be > 1, 1.2 if not set
+	ZipfV        float64         // Zipf offset, must be >= 1, 1 if not set
+}
+
+// DatasetGenerator generates seeded chunks, single owner chunks and files
+// with addresses following a given distribution. For example, 1000 chunks
+// landing in a node's reserve are generated with DistributionNeighbourhood,
+// node's overlay as the target and its storage radius as depth.
+type DatasetGenerator struct {
+	o    DatasetOptions
+	rnd  *rand.Rand
+	zipf *rand.Zipf
+	n    int // number of generated chunks
+}
+
+// NewDatasetGenerator returns new dataset generator
+func NewDatasetGenerator(o DatasetOptions) (*DatasetGenerator, error) {
+	if o.Distribution != DistributionUniform && len(o.Targets) == 0 {
+		return nil, fmt.Errorf("%s distribution requires at least one target", o.Distribution)
+	}
+	if o.PayloadSize <= 0 || o.PayloadSize > swarm.ChunkSize {
+		return nil, fmt.Errorf("payload size must be between 1 and %d", swarm.ChunkSize)
+	}
+	if o.Depth > swarm.MaxPO {
+		return nil, fmt.Errorf("depth must be at most %d", swarm.MaxPO)
+	}
+
+	g := &DatasetGenerator{
+		o:   o,
+		rnd: random.PseudoGenerator(o.Seed),
+	}
+
+	if o.Distribution == DistributionZipf {
+		if o.ZipfS == 0 {
+			o.ZipfS = 1.2
+		}
+		if o.ZipfV == 0 {
+			o.ZipfV = 1
+		}
+		if o.ZipfS <= 1 || o.ZipfV < 1 {
+			return nil, errors.New("zipf distribution requires s > 1 and v >= 1")
+		}
+		g.zipf = rand.NewZipf(g.rnd, o.ZipfS, o.ZipfV, uint64(len(o.Targets)-1))
+	}
+
+	return g, nil
+}
+
+// Chunk returns next generated content addressed chunk
+func (g *DatasetGenerator) Chunk() (swarm.Chunk, error) {
+	return g.chunk(g.o.PayloadSize)
+}
+
+// chunk returns next generated content addressed chunk with payload of a
+// given size
+func (g *DatasetGenerator) chunk(size int) (swarm.Chunk, error) {
+	match := g.next()
+	data := make([]byte, size)
+	for {
+		if _, err := g.rnd.Read(data); err != nil {
+			return nil, fmt.Errorf("create random data: %w", err)
+		}
+
+		ch, err := cac.New(data)
+		if err != nil {
+			return nil, fmt.Errorf("create chunk: %w", err)
+		}
+
+		if match(ch.Address()) {
+			return ch, nil
+		}
+	}
+}
+
+// Chunks returns count generated content addressed chunks
+func (g *DatasetGenerator) Chunks(count int) ([]swarm.Chunk, error) {
+	chunks := make([]swarm.Chunk, count)
+	for i := range chunks {
+		ch, err := g.Chunk()
+		if err != nil {
+			return nil, err
+		}
+		chunks[i] = ch
+	}
+	return chunks, nil
+}
+
+// SOC represents signed single owner chunk
+type SOC struct {
+	Address   swarm.Address
+	Owner     []byte
+	ID        []byte
+	Signature []byte
+	Data      []byte // data of the wrapped content addressed chunk
+}
+
+// SOC returns next generated single owner chunk signed by signer
+func (g *DatasetGenerator) SOC(signer crypto.Signer) (SOC, error) {
+	owner, err := signer.EthereumAddress()
+	if err != nil {
+		return SOC{}, fmt.Errorf("soc owner: %w", err)
+	}
+
+	data := make([]byte, g.o.PayloadSize)
+	if _, err := g.rnd.Read(data); err != nil {
+		return SOC{}, fmt.Errorf("create random data: %w", err)
+	}
+	ch, err := cac.New(data)
+	if err != nil {
+		return SOC{}, fmt.Errorf("create chunk: %w", err)
+	}
+
+	// the address of single owner chunk depends only on its id and owner
+	match := g.next()
+	id := make([]byte, soc.IdSize)
+	for {
+		if _, err := g.rnd.Read(id); err != nil {
+			return SOC{}, fmt.Errorf("create soc id: %w", err)
+		}
+
+		a, err := soc.CreateAddress(id, owner.Bytes())
+		if err != nil {
+			return SOC{}, fmt.Errorf("soc address: %w", err)
+		}
+
+		if match(a) {
+			break
+		}
+	}
+
+	sch, err := soc.New(id, ch).Sign(signer)
+	if err != nil {
+		return SOC{}, fmt.Errorf("sign soc: %w", err)
+	}
+
+	return SOC{
+		Address:   sch.Address(),
+		Owner:     owner.Bytes(),
+		ID:        id,
+		Signature: sch.Data()[soc.IdSize : soc.IdSize+soc.SignatureSize],
+		Data:      ch.Data(),
+	}, nil
+}
+
+// SOCs returns count generated single owner chunks signed by signer
+func (g *DatasetGenerator) SOCs(signer crypto.Signer, count int) ([]SOC, error) {
+	socs := make([]SOC, count)
+	for i := range socs {
+		s, err := g.SOC(signer)
+		if err != nil {
+			return nil, err
+		}
+		socs[i] = s
+	}
+	return socs, nil
+}
+
+// File returns next generated file of a given size. Data of the file is
+// generated chunk by chunk, so that its data chunks follow the distribution;
+// files of uniform distribution are streamed and never held in memory, other
+// files are held in memory and limited to MaxMinedFileSize. Files can be
+// rewound.
+func (g *DatasetGenerator) File(name string, size int64) (File, error) {
+	if g.o.Distribution == DistributionUniform {
+		return NewRandomFileStream(g.rnd.Int63(), name, size), nil
+	}
+	if size > MaxMinedFileSize {
+		return File{}, fmt.Errorf("%s distribution file size %d exceeds %d", g.o.Distribution, size, MaxMinedFileSize)
+	}
+
+	var data bytes.Buffer
+	for remaining := size; remaining > 0; remaining -= swarm.ChunkSize {
+		n := int64(swarm.ChunkSize)
+		if remaining < n {
+			n = remaining
+		}
+
+		ch, err := g.chunk(int(n))
+		if err != nil {
+			return File{}, err
+		}
+		data.Write(ch.Data()[swarm.SpanSize:])
+	}
+
+	b := data.Bytes()
+	newReader := func() io.Reader {
+		return bytes.NewReader(b)
+	}
+
+	return File{
+		name:       name,
+		dataReader: newReader(),
+		size:       int64(len(b)),
+		newReader:  newReader,
+	}, nil
+}
+
+// Files returns count generated files of a given size
+func (g *DatasetGenerator) Files(name string, count int, size int64) ([]File, error) {
+	files := make([]File, count)
+	for i := range files {
+		f, err := g.File(fmt.Sprintf("%s-%d", name, i), size)
+		if err != nil {
+			return nil, err
+		}
+		files[i] = f
+	}
+	return files, nil
+}
+
+// Prefix returns number of leading address bits that generated chunks are
+// assumed to share when stamping them, it is the length of the longest
+// common prefix of chunks in the most densely populated part of the address
+// space
+func (g *DatasetGenerator) Prefix() uint8 {
+	switch g.o.Distribution {
+	case DistributionNeighbourhood, DistributionZipf, DistributionTargets:
+		return g.o.Depth
+	case DistributionBins, DistributionProximity:
+		return g.o.Depth + 1
+	default:
+		return 0
+	}
+}
+
+// next returns function that matches the address of the next generated chunk
+func (g *DatasetGenerator) next() func(swarm.Address) bool {
+	i := g.n
+	g.n++
+
+	switch g.o.Distribution {
+	case DistributionNeighbourhood:
+		return inNeighbourhood(g.o.Targets[0], g.o.Depth)
+	case DistributionBins:
+		target, po := g.o.Targets[0], uint8(i%(int(g.o.Depth)+1))
+		return func(a swarm.Address) bool {
+			return swarm.Proximity(a.Bytes(), target.Bytes()) == po
+		}
+	case DistributionZipf:
+		return inNeighbourhood(g.o.Targets[g.zipf.Uint64()], g.o.Depth)
+	case DistributionTargets:
+		return inNeighbourhood(g.o.Targets[i%len(g.o.Targets)], g.o.Depth)
+	case DistributionProximity:
+		target := g.o.Targets[0]
+		return func(a swarm.Address) bool {
+			return swarm.Proximity(a.Bytes(), target.Bytes()) == g.o.Depth
+		}
+	default:
+		return func(swarm.Address) bool { return true }
+	}
+}
+
+// inNeighbourhood returns function that matches addresses in the neighbourhood
+// of a given depth of the target
+func inNeighbourhood(target swarm.Address, depth uint8) func(swarm.Address) bool {
+	return func(a swarm.Address) bool {
+		return swarm.Proximity(a.Bytes(), target.Bytes()) >= depth
+	}
+}
+
+// ReserveDataset returns dataset generator of chunks that land in the node's
+// reserve, that is in its neighbourhood of the depth of its storage radius
+func (c *Client) ReserveDataset(ctx context.Context, seed int64, payloadSize int) (*DatasetGenerator, error) {
+	overlay, err := c.Overlay(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := c.ReserveState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("reserve state: %w", err)
+	}
+
+	return NewDatasetGenerator(DatasetOptions{
+		Seed:         seed,
+		Distribution: DistributionNeighbourhood,
+		Targets:      []swarm.Address{overlay},
+		Depth:        state.Radius,
+		PayloadSize:  payloadSize,
+	})
+}
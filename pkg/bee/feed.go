@@ -0,0 +1,110 @@
+package bee
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ethersphere/bee/pkg/cac"
+	"github.com/ethersphere/bee/pkg/crypto"
+	"github.com/ethersphere/bee/pkg/feeds"
+	"github.com/ethersphere/bee/pkg/soc"
+	"github.com/ethersphere/bee/pkg/swarm"
+)
+
+// Feed represents sequential feed whose updates are signed locally
+type Feed struct {
+	signer crypto.Signer
+	owner  []byte
+	topic  []byte
+	index  uint64
+}
+
+// NewFeed returns new sequential feed of a given signer and topic
+func NewFeed(signer crypto.Signer, topic []byte) (*Feed, error) {
+	owner, err := signer.EthereumAddress()
+	if err != nil {
+		return nil, fmt.Errorf("feed owner: %w", err)
+	}
+
+	return &Feed{
+		signer: signer,
+		owner:  owner.Bytes(),
+		topic:  topic,
+	}, nil
+}
+
+// Owner returns hex encoded feed's owner
+func (f *Feed) Owner() string {
+	return hex.EncodeToString(f.owner)
+}
+
+// Topic returns hex encoded feed's topic
+func (f *Feed) Topic() string {
+	return hex.EncodeToString(f.topic)
+}
+
+// Index returns index of the next feed's update
+func (f *Feed) Index() uint64 {
+	return f.index
+}
+
+// FeedUpdate represents feed's update in the form of a single owner chunk
+type FeedUpdate struct {
+	Index     uint64
+	Address   swarm.Address
+	ID        []byte
+	Signature []byte
+	Data      []byte // data of the wrapped content addressed chunk
+}
+
+// Next returns next sequential update of the feed that wraps payload
+// timestamped with at
+func (f *Feed) Next(at int64, payload []byte) (u FeedUpdate, err error) {
+	id, err := feeds.Id(f.topic, feedIndex(f.index))
+	if err != nil {
+		return FeedUpdate{}, fmt.Errorf("feed update %d id: %w", f.index, err)
+	}
+
+	ts := make([]byte, 8)
+	binary.BigEndian.PutUint64(ts, uint64(at))
+	ch, err := cac.New(append(ts, payload...))
+	if err != nil {
+		return FeedUpdate{}, fmt.Errorf("feed update %d chunk: %w", f.index, err)
+	}
+
+	sch, err := soc.New(id, ch).Sign(f.signer)
+	if err != nil {
+		return FeedUpdate{}, fmt.Errorf("feed update %d sign: %w", f.index, err)
+	}
+
+	u = FeedUpdate{
+		Index:     f.index,
+		Address:   sch.Address(),
+		ID:        id,
+		Signature: sch.Data()[soc.IdSize : soc.IdSize+soc.SignatureSize],
+		Data:      ch.Data(),
+	}
+	f.index++
+
+	return
+}
+
+// feedIndex represents index of sequential feed
+type feedIndex uint64
+
+var _ feeds.Index = feedIndex(0)
+
+func (i feedIndex) MarshalBinary() ([]byte, error) {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(i))
+	return b, nil
+}
+
+func (i feedIndex) Next(last int64, at uint64) feeds.Index {
+	return i + 1
+}
+
+func (i feedIndex) String() string {
+	return fmt.Sprintf("%d", uint64(i))
+}
@@ -1,12 +1,15 @@
 package bee
 
 import (
+	"archive/tar"
 	"bytes"
+	"errors"
 	"fmt"
 	"hash"
 	"io"
 	"math/rand"
 
+	"github.com/ethersphere/bee/pkg/encryption"
 	"github.com/ethersphere/bee/pkg/swarm"
 	"golang.org/x/crypto/sha3"
 )
@@ -18,6 +21,11 @@ type File struct {
 	hash       []byte
 	dataReader io.Reader
 	size       int64
+	// newReader regenerates file data from the beginning, it is set only for
+	// streamed files whose data is never held in memory
+	newReader func() io.Reader
+	// collection holds name, size and hash of every file in a collection
+	collection []File
 }
 
 // NewRandomFile returns new pseudorandom file
@@ -29,6 +37,23 @@ func NewRandomFile(r *rand.Rand, name string, size int64) File {
 	}
 }
 
+// NewRandomFileStream returns new pseudorandom file whose data is generated
+// lazily from the seed while it is being read. Data is never held in memory,
+// so the file can be arbitrarily large, and it can be read again from the
+// beginning with Rewind.
+func NewRandomFileStream(seed int64, name string, size int64) File {
+	newReader := func() io.Reader {
+		return io.LimitReader(rand.New(rand.NewSource(seed)), size)
+	}
+
+	return File{
+		name:       name,
+		dataReader: newReader(),
+		size:       size,
+		newReader:  newReader,
+	}
+}
+
 // NewBufferFile returns new file with specified buffer
 func NewBufferFile(name string, buffer *bytes.Buffer) File {
 	return File{
@@ -38,11 +63,58 @@ func NewBufferFile(name string, buffer *bytes.Buffer) File {
 	}
 }
 
+// NewCollectionFile returns new file containing TAR collection of given files.
+// Data readers of given files are consumed.
+func NewCollectionFile(name string, files []File) (File, error) {
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+
+	var collection []File
+	for _, file := range files {
+		// create tar header and write it
+		hdr := &tar.Header{
+			Name: file.Name(),
+			Mode: 0600,
+			Size: file.Size(),
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			return File{}, err
+		}
+
+		// write the file data to the tar
+		h := fileHasher()
+		if _, err := io.Copy(tw, io.TeeReader(file.DataReader(), h)); err != nil {
+			return File{}, err
+		}
+		collection = append(collection, File{name: file.Name(), size: file.Size(), hash: h.Sum(nil)})
+	}
+
+	if err := tw.Close(); err != nil {
+		return File{}, err
+	}
+
+	f := NewBufferFile(name, &buf)
+	f.collection = collection
+	return f, nil
+}
+
 // CalculateHash calculates hash from dataReader.
 // It replaces dataReader with another that will contain the data.
+// Streamed files are hashed incrementally and rewound instead of buffered.
 func (f *File) CalculateHash() error {
 	h := fileHasher()
 
+	if f.newReader != nil {
+		if _, err := io.Copy(h, f.DataReader()); err != nil {
+			return err
+		}
+
+		f.hash = h.Sum(nil)
+		f.dataReader = f.newReader()
+
+		return nil
+	}
+
 	var buf bytes.Buffer
 	tee := io.TeeReader(f.DataReader(), &buf)
 
@@ -57,6 +129,18 @@ func (f *File) CalculateHash() error {
 	return nil
 }
 
+// Rewind resets file's data reader to the beginning of the data, so that the
+// file can be uploaded again, e.g. on retry. Only streamed files can be rewound.
+func (f *File) Rewind() error {
+	if f.newReader == nil {
+		return errors.New("file can not be rewound")
+	}
+
+	f.dataReader = f.newReader()
+
+	return nil
+}
+
 // Address returns file's address
 func (f *File) Address() swarm.Address {
 	return f.address
@@ -84,9 +168,15 @@ func (f *File) Size() int64 {
 
 // ClosestNode returns file's closest node of a given set of nodes
 func (f *File) ClosestNode(nodes []swarm.Address) (closest swarm.Address, err error) {
+	// encrypted reference is root chunk address followed by decryption key
+	addr := f.Address().Bytes()
+	if len(addr) > swarm.HashSize {
+		addr = addr[:swarm.HashSize]
+	}
+
 	closest = nodes[0]
 	for _, a := range nodes[1:] {
-		dcmp, err := swarm.DistanceCmp(f.Address().Bytes(), closest.Bytes(), a.Bytes())
+		dcmp, err := swarm.DistanceCmp(addr, closest.Bytes(), a.Bytes())
 		if err != nil {
 			return swarm.Address{}, fmt.Errorf("find closest node: %w", err)
 		}
@@ -105,6 +195,14 @@ func (f *File) ClosestNode(nodes []swarm.Address) (closest swarm.Address, err er
 	return
 }
 
+// ErrReferenceType is returned when reference type does not match requested encryption
+var ErrReferenceType = errors.New("reference does not match requested encryption")
+
+// IsEncryptedReference returns true if reference points to encrypted content
+func IsEncryptedReference(a swarm.Address) bool {
+	return len(a.Bytes()) == encryption.ReferenceSize
+}
+
 func fileHasher() hash.Hash {
 	return sha3.New256()
 }
@@ -78,6 +78,8 @@ func (g *NodeGroup) AddNode(name string, o NodeOptions) (err error) {
 		DebugAPIURL:         dURL,
 		DebugAPIInsecureTLS: g.cluster.debugAPIInsecureTLS,
 		Retry:               5,
+		Name:                name,
+		Ledger:              g.cluster.ledger,
 	})
 
 	// TODO: make more granular, check every sub-option
@@ -9,16 +9,16 @@ import (
 const MinimumBatchDepth = 11
 
 func EstimatePostageBatchDepth(contentLength int64) uint64 {
-	depth := uint64(math.Log2(float64(calculateNumberOfChunks(contentLength, false))))
+	depth := uint64(math.Log2(float64(CalculateNumberOfChunks(contentLength, false))))
 	if depth < MinimumBatchDepth {
 		depth = MinimumBatchDepth
 	}
 	return depth
 }
 
-// calculateNumberOfChunks calculates the number of chunks in an arbitrary
+// CalculateNumberOfChunks calculates the number of chunks in an arbitrary
 // content length.
-func calculateNumberOfChunks(contentLength int64, isEncrypted bool) int64 {
+func CalculateNumberOfChunks(contentLength int64, isEncrypted bool) int64 {
 	if contentLength <= swarm.ChunkSize {
 		return 1
 	}
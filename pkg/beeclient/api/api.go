@@ -9,6 +9,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 
 	"github.com/ethersphere/beekeeper"
@@ -18,6 +19,9 @@ const (
 	apiVersion              = "v1"
 	contentType             = "application/json; charset=utf-8"
 	postageStampBatchHeader = "Swarm-Postage-Batch-Id"
+	encryptHeader           = "Swarm-Encrypt"
+	indexDocumentHeader     = "Swarm-Index-Document"
+	errorDocumentHeader     = "Swarm-Error-Document"
 )
 
 var userAgent = "beekeeper/" + beekeeper.Version
@@ -37,6 +41,7 @@ type Client struct {
 	PSS     *PSSService
 	SOC     *SOCService
 	Postage *PostageService
+	Feeds   *FeedsService
 }
 
 // ClientOptions holds optional parameters for the Client.
@@ -70,6 +75,7 @@ func newClient(httpClient *http.Client) (c *Client) {
 	c.PSS = (*PSSService)(&c.service)
 	c.SOC = (*SOCService)(&c.service)
 	c.Postage = (*PostageService)(&c.service)
+	c.Feeds = (*FeedsService)(&c.service)
 	return c
 }
 
@@ -155,9 +161,16 @@ func encodeJSON(w io.Writer, v interface{}) (err error) {
 
 // requestData handles the HTTP request response cycle.
 func (c *Client) requestData(ctx context.Context, method, path string, body io.Reader, v interface{}) (resp io.ReadCloser, err error) {
+	resp, _, err = c.requestDataWithResponseHeader(ctx, method, path, body)
+	return
+}
+
+// requestDataWithResponseHeader handles the HTTP request response cycle and
+// returns response body together with response headers.
+func (c *Client) requestDataWithResponseHeader(ctx context.Context, method, path string, body io.Reader) (resp io.ReadCloser, h http.Header, err error) {
 	req, err := http.NewRequest(method, path, body)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	req = req.WithContext(ctx)
 
@@ -168,42 +181,59 @@ func (c *Client) requestData(ctx context.Context, method, path string, body io.R
 
 	r, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	if err = responseErrorHandler(r); err != nil {
-		return nil, err
+		drain(r.Body)
+		return nil, r.Header, err
 	}
 
-	return r.Body, nil
+	return r.Body, r.Header, nil
 }
 
 // requestWithHeader handles the HTTP request response cycle.
 func (c *Client) requestWithHeader(ctx context.Context, method, path string, header http.Header, body io.Reader, v interface{}) (err error) {
+	_, err = c.requestWithResponseHeader(ctx, method, path, header, body, v)
+	return err
+}
+
+// requestWithResponseHeader handles the HTTP request response cycle and
+// returns headers of the response.
+func (c *Client) requestWithResponseHeader(ctx context.Context, method, path string, header http.Header, body io.Reader, v interface{}) (h http.Header, err error) {
 	req, err := http.NewRequest(method, path, body)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	req = req.WithContext(ctx)
 
 	req.Header = header
 	req.Header.Add("Accept", contentType)
 
+	// set content length explicitly for streamed bodies, as the header value
+	// is ignored by the transport and the body would be sent chunked
+	if cl := header.Get("Content-Length"); cl != "" && body != nil {
+		if req.ContentLength, err = strconv.ParseInt(cl, 10, 64); err != nil {
+			return nil, err
+		}
+	}
+
 	r, err := c.httpClient.Do(req)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	defer drain(r.Body)
 
 	if err = responseErrorHandler(r); err != nil {
-		return err
+		return r.Header, err
 	}
 
 	if v != nil && strings.Contains(r.Header.Get("Content-Type"), "application/json") {
 		_ = json.NewDecoder(r.Body).Decode(&v)
-		return err
+		return r.Header, err
 	}
 
-	return err
+	return r.Header, err
 }
 
 // drain discards all of the remaining data from the reader and closes it,
@@ -235,6 +265,8 @@ func responseErrorHandler(r *http.Response) (err error) {
 		return decodeBadRequest(r)
 	case http.StatusUnauthorized:
 		return ErrUnauthorized
+	case http.StatusPaymentRequired:
+		return ErrPaymentRequired
 	case http.StatusForbidden:
 		return ErrForbidden
 	case http.StatusNotFound:
@@ -255,7 +287,8 @@ func responseErrorHandler(r *http.Response) (err error) {
 func decodeBadRequest(r *http.Response) (err error) {
 
 	type badRequestResponse struct {
-		Errors []string `json:"errors"`
+		Errors  []string `json:"errors"`
+		Message string   `json:"message"`
 	}
 
 	if !strings.Contains(r.Header.Get("Content-Type"), "application/json") {
@@ -268,6 +301,9 @@ func decodeBadRequest(r *http.Response) (err error) {
 		}
 		return err
 	}
+	if len(e.Errors) == 0 && e.Message != "" {
+		return NewBadRequestError(e.Message)
+	}
 	return NewBadRequestError(e.Errors...)
 }
 
@@ -295,4 +331,10 @@ type UploadOptions struct {
 	Pin     bool
 	Tag     uint32
 	BatchID string
+	// Encrypt uploads data encrypted, resulting in 64 bytes long references
+	Encrypt bool
+	// IndexDocument and ErrorDocument are default and not found documents of
+	// an uploaded collection, they are used only for collection uploads
+	IndexDocument string
+	ErrorDocument string
 }
@@ -32,6 +32,9 @@ func (b *BytesService) Upload(ctx context.Context, data io.Reader, o UploadOptio
 	if o.Tag != 0 {
 		h.Add("Swarm-Tag", strconv.FormatUint(uint64(o.Tag), 10))
 	}
+	if o.Encrypt {
+		h.Add(encryptHeader, "true")
+	}
 	h.Add(postageStampBatchHeader, o.BatchID)
 	err := b.client.requestWithHeader(ctx, http.MethodPost, "/"+apiVersion+"/bytes", h, data, &resp)
 	return resp, err
@@ -4,7 +4,9 @@ import (
 	"context"
 	"io"
 	"net/http"
+	"net/url"
 	"strconv"
+	"strings"
 
 	"github.com/ethersphere/bee/pkg/swarm"
 )
@@ -14,7 +16,22 @@ type DirsService service
 
 // Download downloads data from the node
 func (s *DirsService) Download(ctx context.Context, a swarm.Address, path string) (resp io.ReadCloser, err error) {
-	return s.client.requestData(ctx, http.MethodGet, "/"+apiVersion+"/bzz/"+a.String()+"/"+path, nil, nil)
+	return s.client.requestData(ctx, http.MethodGet, "/"+apiVersion+"/bzz/"+a.String()+"/"+escapePath(path), nil, nil)
+}
+
+// DownloadWithHeader downloads data from the node and returns response headers
+func (s *DirsService) DownloadWithHeader(ctx context.Context, a swarm.Address, path string) (resp io.ReadCloser, h http.Header, err error) {
+	return s.client.requestDataWithResponseHeader(ctx, http.MethodGet, "/"+apiVersion+"/bzz/"+a.String()+"/"+escapePath(path), nil)
+}
+
+// escapePath escapes each segment of the collection path, so that paths with
+// unicode, spaces and percent signs are requested as they are in the collection
+func escapePath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, s := range segments {
+		segments[i] = url.PathEscape(s)
+	}
+	return strings.Join(segments, "/")
 }
 
 // DirsUploadResponse represents Upload's response
@@ -28,6 +45,21 @@ func (s *DirsService) Upload(ctx context.Context, data io.Reader, size int64, o
 	header.Set("Content-Type", "application/x-tar")
 	header.Set("Content-Length", strconv.FormatInt(size, 10))
 	header.Set("swarm-collection", "True")
+	if o.Pin {
+		header.Set("Swarm-Pin", "true")
+	}
+	if o.Tag != 0 {
+		header.Set("Swarm-Tag", strconv.FormatUint(uint64(o.Tag), 10))
+	}
+	if o.Encrypt {
+		header.Set(encryptHeader, "true")
+	}
+	if o.IndexDocument != "" {
+		header.Set(indexDocumentHeader, o.IndexDocument)
+	}
+	if o.ErrorDocument != "" {
+		header.Set(errorDocumentHeader, o.ErrorDocument)
+	}
 	header.Set(postageStampBatchHeader, o.BatchID)
 
 	err = s.client.requestWithHeader(ctx, http.MethodPost, "/"+apiVersion+"/bzz", header, data, &resp)
@@ -30,6 +30,7 @@ func (e *BadRequestError) Errors() (errs []string) {
 // Errors that are returned by the API.
 var (
 	ErrUnauthorized        = errors.New("unauthorized")
+	ErrPaymentRequired     = errors.New("payment required")
 	ErrForbidden           = errors.New("forbidden")
 	ErrNotFound            = errors.New("not found")
 	ErrMethodNotAllowed    = errors.New("method not allowed")
@@ -0,0 +1,73 @@
+package api
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+
+	"github.com/ethersphere/bee/pkg/swarm"
+)
+
+const (
+	feedIndexHeader     = "Swarm-Feed-Index"
+	feedIndexNextHeader = "Swarm-Feed-Index-Next"
+)
+
+// FeedsService represents Bee's Feeds service
+type FeedsService service
+
+// FeedManifestResponse represents CreateFeedManifest's response
+type FeedManifestResponse struct {
+	Reference swarm.Address `json:"reference"`
+}
+
+// CreateFeedManifest creates feed manifest for the feed of a given owner and topic
+func (f *FeedsService) CreateFeedManifest(ctx context.Context, owner, topic, batchID string) (resp FeedManifestResponse, err error) {
+	h := http.Header{}
+	h.Add(postageStampBatchHeader, batchID)
+	url := fmt.Sprintf("/%s/feeds/%s/%s", apiVersion, owner, topic)
+
+	err = f.client.requestWithHeader(ctx, http.MethodPost, url, h, nil, &resp)
+	return
+}
+
+// FeedUpdateResponse represents FindFeedUpdate's response
+type FeedUpdateResponse struct {
+	Reference swarm.Address `json:"reference"`
+	Index     uint64        `json:"-"`
+	NextIndex uint64        `json:"-"`
+}
+
+// FindFeedUpdate finds the latest update of the feed of a given owner and topic
+func (f *FeedsService) FindFeedUpdate(ctx context.Context, owner, topic string) (resp FeedUpdateResponse, err error) {
+	url := fmt.Sprintf("/%s/feeds/%s/%s", apiVersion, owner, topic)
+
+	h, err := f.client.requestWithResponseHeader(ctx, http.MethodGet, url, http.Header{}, nil, &resp)
+	if err != nil {
+		return FeedUpdateResponse{}, err
+	}
+
+	if resp.Index, err = parseFeedIndex(h.Get(feedIndexHeader)); err != nil {
+		return FeedUpdateResponse{}, fmt.Errorf("feed index: %w", err)
+	}
+	if resp.NextIndex, err = parseFeedIndex(h.Get(feedIndexNextHeader)); err != nil {
+		return FeedUpdateResponse{}, fmt.Errorf("feed next index: %w", err)
+	}
+
+	return
+}
+
+// parseFeedIndex parses hex encoded sequential feed index
+func parseFeedIndex(s string) (uint64, error) {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return 0, err
+	}
+	if len(b) != 8 {
+		return 0, fmt.Errorf("invalid index length %d", len(b))
+	}
+
+	return binary.BigEndian.Uint64(b), nil
+}
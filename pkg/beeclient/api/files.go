@@ -34,6 +34,9 @@ func (f *FilesService) Upload(ctx context.Context, name string, data io.Reader,
 	if o.Tag != 0 {
 		header.Set("Swarm-Tag", strconv.FormatUint(uint64(o.Tag), 10))
 	}
+	if o.Encrypt {
+		header.Set(encryptHeader, "true")
+	}
 	header.Set(postageStampBatchHeader, o.BatchID)
 
 	err = f.client.requestWithHeader(ctx, http.MethodPost, "/"+apiVersion+"/bzz?"+url.QueryEscape("name="+name), header, data, &resp)
@@ -3,7 +3,9 @@ package api
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"net/http"
+	"strings"
 )
 
 // PostageService represents Bee's Postage service
@@ -14,8 +16,34 @@ type postageResponse struct {
 }
 
 type PostageStampResponse struct {
-	BatchID     string `json:"batchID"`
-	Utilization uint32 `json:"utilization"`
+	BatchID     string  `json:"batchID"`
+	Utilization uint32  `json:"utilization"`
+	Usable      bool    `json:"usable"`
+	Label       string  `json:"label"`
+	Depth       uint8   `json:"depth"`
+	BucketDepth uint8   `json:"bucketDepth"`
+	Amount      *BigInt `json:"amount"`
+}
+
+// BigInt represents big integer that may be encoded as JSON number or string
+type BigInt struct {
+	*big.Int
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface
+func (i *BigInt) UnmarshalJSON(b []byte) error {
+	s := strings.Trim(string(b), `"`)
+	if s == "null" {
+		return nil
+	}
+
+	v, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return fmt.Errorf("invalid big integer %s", s)
+	}
+	i.Int = v
+
+	return nil
 }
 
 type postageStampsResponse struct {
@@ -42,3 +70,9 @@ func (p *PostageService) PostageBatches(ctx context.Context) ([]PostageStampResp
 	}
 	return resp.Stamps, nil
 }
+
+// Fetches the postage stamp batch with a given ID
+func (p *PostageService) PostageBatch(ctx context.Context, batchID string) (resp PostageStampResponse, err error) {
+	err = p.client.request(ctx, http.MethodGet, fmt.Sprintf("/%s/stamps/%s", apiVersion, batchID), nil, &resp)
+	return
+}
@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"strconv"
 	"time"
@@ -42,6 +43,24 @@ func (p *TagsService) GetTag(ctx context.Context, tagUID uint32) (resp TagRespon
 	return resp, err
 }
 
+// TagsResponse represents ListTags's response
+type TagsResponse struct {
+	Tags []TagResponse `json:"tags"`
+}
+
+// ListTags lists tags starting from offset, limited to a given number of tags
+func (p *TagsService) ListTags(ctx context.Context, offset, limit int) (resp TagsResponse, err error) {
+	err = p.client.requestJSON(ctx, http.MethodGet, fmt.Sprintf("/tags?offset=%d&limit=%d", offset, limit), nil, &resp)
+	return
+}
+
+// DeleteTag deletes tag
+func (p *TagsService) DeleteTag(ctx context.Context, tagUID uint32) (err error) {
+	tag := strconv.FormatUint(uint64(tagUID), 10)
+
+	return p.client.requestJSON(ctx, http.MethodDelete, "/tags/"+tag, nil, nil)
+}
+
 func (p *TagsService) WaitSync(ctx context.Context, tagUID uint32) (err error) {
 
 	c := make(chan bool)
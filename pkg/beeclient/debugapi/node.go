@@ -4,6 +4,8 @@ import (
 	"context"
 	"math/big"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/ethersphere/bee/pkg/swarm"
@@ -50,6 +52,33 @@ func (n *NodeService) Balances(ctx context.Context) (resp Balances, err error) {
 	return
 }
 
+// Blocklist returns node's blocklisted peers
+func (n *NodeService) Blocklist(ctx context.Context) (resp Peers, err error) {
+	err = n.client.requestJSON(ctx, http.MethodGet, "/blocklist", nil, &resp)
+	return
+}
+
+// Connect represents Connect's response
+type Connect struct {
+	Address swarm.Address `json:"address"`
+}
+
+// Connect connects node to a peer with a given multiaddress
+func (n *NodeService) Connect(ctx context.Context, multiaddr string) (resp Connect, err error) {
+	err = n.client.requestJSON(ctx, http.MethodPost, "/connect/"+strings.TrimPrefix(multiaddr, "/"), nil, &resp)
+	return
+}
+
+// Disconnect disconnects node from a given peer
+func (n *NodeService) Disconnect(ctx context.Context, a swarm.Address) error {
+	resp := struct {
+		Message string `json:"message,omitempty"`
+		Code    int    `json:"code,omitempty"`
+	}{}
+
+	return n.client.requestJSON(ctx, http.MethodDelete, "/peers/"+a.String(), nil, &resp)
+}
+
 // HasChunk returns true/false if node has a chunk
 func (n *NodeService) HasChunk(ctx context.Context, a swarm.Address) (bool, error) {
 	resp := struct {
@@ -185,6 +214,42 @@ func (n *NodeService) ChequebookBalance(ctx context.Context) (resp ChequebookBal
 	return
 }
 
+// ChequebookPeerCheques represents last cheques sent to and received from a peer
+type ChequebookPeerCheques struct {
+	Peer         string  `json:"peer"`
+	LastReceived *Cheque `json:"lastreceived"`
+	LastSent     *Cheque `json:"lastsent"`
+}
+
+// ChequebookCheque returns last cheques sent to and received from a given peer
+func (n *NodeService) ChequebookCheque(ctx context.Context, a swarm.Address) (resp ChequebookPeerCheques, err error) {
+	err = n.client.request(ctx, http.MethodGet, "/chequebook/cheque/"+a.String(), nil, &resp)
+	return
+}
+
+// ChequebookCheques represents last cheques sent to and received from all peers
+type ChequebookCheques struct {
+	LastCheques []ChequebookPeerCheques `json:"lastcheques"`
+}
+
+// ChequebookCheques returns last cheques sent to and received from all peers
+func (n *NodeService) ChequebookCheques(ctx context.Context) (resp ChequebookCheques, err error) {
+	err = n.client.request(ctx, http.MethodGet, "/chequebook/cheque", nil, &resp)
+	return
+}
+
+// ChequebookDeposit deposits amount from node's wallet to its chequebook
+func (n *NodeService) ChequebookDeposit(ctx context.Context, amount int64) (resp TransactionHashResponse, err error) {
+	err = n.client.request(ctx, http.MethodPost, "/chequebook/deposit?amount="+strconv.FormatInt(amount, 10), nil, &resp)
+	return
+}
+
+// ChequebookWithdraw withdraws amount from node's chequebook to its wallet
+func (n *NodeService) ChequebookWithdraw(ctx context.Context, amount int64) (resp TransactionHashResponse, err error) {
+	err = n.client.request(ctx, http.MethodPost, "/chequebook/withdraw?amount="+strconv.FormatInt(amount, 10), nil, &resp)
+	return
+}
+
 // Topology represents Kademlia topology
 type Topology struct {
 	BaseAddr       swarm.Address  `json:"baseAddr"`
@@ -4,6 +4,8 @@ import (
 	"context"
 	"math/big"
 	"net/http"
+
+	"github.com/ethersphere/beekeeper/pkg/beeclient/api"
 )
 
 type PostageService service
@@ -21,3 +23,27 @@ func (p *PostageService) Reservestate(ctx context.Context) (ReserveState, error)
 	err := p.client.request(ctx, http.MethodGet, "/reservestate", nil, &resp)
 	return resp, err
 }
+
+// Batch represents postage batch as it is known by the node's batch store,
+// regardless of its owner
+type Batch struct {
+	BatchID       string      `json:"batchID"`
+	Value         *api.BigInt `json:"value"`
+	Start         uint64      `json:"start"`
+	Owner         string      `json:"owner"`
+	Depth         uint8       `json:"depth"`
+	BucketDepth   uint8       `json:"bucketDepth"`
+	ImmutableFlag bool        `json:"immutableFlag"`
+	BatchTTL      int64       `json:"batchTTL"`
+}
+
+type batchesResponse struct {
+	Batches []Batch `json:"batches"`
+}
+
+// Batches returns all valid postage batches known by the node
+func (p *PostageService) Batches(ctx context.Context) ([]Batch, error) {
+	var resp batchesResponse
+	err := p.client.request(ctx, http.MethodGet, "/batches", nil, &resp)
+	return resp.Batches, err
+}
@@ -0,0 +1,107 @@
+package chaos
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethersphere/beekeeper/pkg/dynamick8s"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// Backends that inject chaos experiments
+const (
+	BackendChaosMesh = "chaos-mesh"
+	BackendNative    = "native"
+)
+
+var (
+	errExperimentFailed = errors.New("chaos experiment failed")
+	// ErrScenarioNotSupported is returned when backend can not run the scenario
+	ErrScenarioNotSupported = errors.New("scenario not supported by backend")
+)
+
+// Backend injects chaos experiments into the cluster
+type Backend interface {
+	// Check checks that the backend is available
+	Check(ctx context.Context) error
+	// Create starts the experiment
+	Create(ctx context.Context, s Spec) error
+	// Update changes the running experiment
+	Update(ctx context.Context, s Spec) error
+	// Delete stops the experiment and reverts its faults
+	Delete(ctx context.Context, s Spec) error
+	// WaitInjected waits until the experiment takes effect
+	WaitInjected(ctx context.Context, s Spec) error
+}
+
+// compile check whether ChaosMesh implements interface
+var _ Backend = (*ChaosMesh)(nil)
+
+// ChaosMesh is a backend that runs experiments as Chaos Mesh objects
+type ChaosMesh struct {
+	kubeconfig string
+	namespace  string
+}
+
+// NewChaosMesh returns Chaos Mesh backend that injects experiments into pods
+// in the namespace
+func NewChaosMesh(kubeconfig, namespace string) *ChaosMesh {
+	return &ChaosMesh{
+		kubeconfig: kubeconfig,
+		namespace:  namespace,
+	}
+}
+
+// Check checks that Chaos Mesh is installed
+func (c *ChaosMesh) Check(ctx context.Context) error {
+	return CheckChaosMesh(ctx, c.kubeconfig)
+}
+
+// Create creates chaos object of the experiment
+func (c *ChaosMesh) Create(ctx context.Context, s Spec) error {
+	return Create(ctx, c.kubeconfig, c.namespace, s)
+}
+
+// Update updates chaos object of the experiment
+func (c *ChaosMesh) Update(ctx context.Context, s Spec) error {
+	return Update(ctx, c.kubeconfig, c.namespace, s)
+}
+
+// Delete deletes chaos object of the experiment
+func (c *ChaosMesh) Delete(ctx context.Context, s Spec) error {
+	return Delete(ctx, c.kubeconfig, c.namespace, s)
+}
+
+// WaitInjected polls experiment status until chaos is injected into the
+// selected pods, or the context is done
+func (c *ChaosMesh) WaitInjected(ctx context.Context, s Spec) error {
+	client, err := dynamick8s.NewClient(c.kubeconfig, chaosNamespace, s.resource())
+	if err != nil {
+		return err
+	}
+
+	name := ObjectName(s, c.namespace)
+	for {
+		object, err := client.Get(ctx, name)
+		if err != nil {
+			return err
+		}
+
+		phase, _, _ := unstructured.NestedString(object.Object, "status", "experiment", "phase")
+		switch phase {
+		case "Running", "Finished":
+			return nil
+		case "Failed":
+			message, _, _ := unstructured.NestedString(object.Object, "status", "failedMessage")
+			return fmt.Errorf("%w: %s: %s", errExperimentFailed, name, message)
+		}
+
+		select {
+		case <-time.After(time.Second):
+		case <-ctx.Done():
+			return fmt.Errorf("waiting for chaos experiment %s, phase %q: %w", name, phase, ctx.Err())
+		}
+	}
+}
@@ -0,0 +1,223 @@
+package chaos
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Scenarios that can be run as an experiment
+const (
+	ScenarioPodFailure       = "podfailure"
+	ScenarioPodKill          = "podkill"
+	ScenarioNetworkPartition = "networkpartition"
+	ScenarioNetworkLoss      = "networkloss"
+	ScenarioNetworkDelay     = "networkdelay"
+	ScenarioNetworkDuplicate = "networkduplicate"
+	ScenarioNetworkCorrupt   = "networkcorrupt"
+	ScenarioStress           = "stress"
+	ScenarioIOLatency        = "iolatency"
+	ScenarioIOFault          = "iofault"
+	ScenarioTimeSkew         = "timeskew"
+)
+
+// Experiment represents chaos scenario with its parameters as they are given
+// on the command line; it is converted to a typed spec of the scenario
+type Experiment struct {
+	Scenario    string
+	Mode        string
+	Value       string
+	Podname     string
+	Duration    string
+	Cron        string
+	Mode2       string
+	Value2      string
+	Podname2    string
+	Direction   string
+	Correlation string
+	Loss        string
+	Latency     string
+	Jitter      string
+	Duplicate   string
+	Corrupt     string
+	Workers     string
+	CPULoad     string
+	MemorySize  string
+	VolumePath  string
+	Path        string
+	Errno       string
+	Percent     string
+	TimeOffset  string
+}
+
+// NewExperiment returns experiment of the scenario with default parameters
+func NewExperiment(scenario string) Experiment {
+	return Experiment{
+		Scenario:    scenario,
+		Mode:        string(ModeOne),
+		Podname:     "bee",
+		Mode2:       string(ModeOne),
+		Podname2:    "bee",
+		Direction:   DirectionBoth,
+		Correlation: "0",
+		Jitter:      "0ms",
+		Workers:     "1",
+		VolumePath:  DefaultVolumePath,
+		Errno:       "5",
+		Percent:     "100",
+	}
+}
+
+// ParseSpec parses and validates experiment specification in the form
+// scenario[:key=value,...], for example
+// networkdelay:mode=all,latency=100ms,jitter=10ms. Keys are named as the
+// turn-on command flags.
+func ParseSpec(spec string) (Spec, error) {
+	scenario, params := spec, ""
+	if i := strings.Index(spec, ":"); i >= 0 {
+		scenario, params = spec[:i], spec[i+1:]
+	}
+	e := NewExperiment(strings.TrimSpace(scenario))
+
+	fields := map[string]*string{
+		"mode":        &e.Mode,
+		"value":       &e.Value,
+		"podname":     &e.Podname,
+		"duration":    &e.Duration,
+		"cron":        &e.Cron,
+		"mode2":       &e.Mode2,
+		"value2":      &e.Value2,
+		"podname2":    &e.Podname2,
+		"direction":   &e.Direction,
+		"correlation": &e.Correlation,
+		"loss":        &e.Loss,
+		"latency":     &e.Latency,
+		"jitter":      &e.Jitter,
+		"duplicate":   &e.Duplicate,
+		"corrupt":     &e.Corrupt,
+		"workers":     &e.Workers,
+		"cpu-load":    &e.CPULoad,
+		"memory-size": &e.MemorySize,
+		"volume-path": &e.VolumePath,
+		"path":        &e.Path,
+		"errno":       &e.Errno,
+		"percent":     &e.Percent,
+		"time-offset": &e.TimeOffset,
+	}
+	if params != "" {
+		for _, p := range strings.Split(params, ",") {
+			kv := strings.SplitN(p, "=", 2)
+			if len(kv) != 2 {
+				return nil, fmt.Errorf("chaos spec %q: parameter %q is not in key=value form", spec, p)
+			}
+			f, ok := fields[strings.TrimSpace(kv[0])]
+			if !ok {
+				keys := make([]string, 0, len(fields))
+				for k := range fields {
+					keys = append(keys, k)
+				}
+				sort.Strings(keys)
+				return nil, fmt.Errorf("chaos spec %q: unknown parameter %q, must be one of %s", spec, kv[0], strings.Join(keys, ", "))
+			}
+			*f = strings.TrimSpace(kv[1])
+		}
+	}
+
+	s, err := e.Spec()
+	if err != nil {
+		return nil, fmt.Errorf("chaos spec %q: %w", spec, err)
+	}
+	if err := s.Validate(); err != nil {
+		return nil, fmt.Errorf("chaos spec %q: %w", spec, err)
+	}
+
+	return s, nil
+}
+
+// Spec converts experiment parameters to typed spec of the scenario. It
+// returns error for malformed parameters, empty parameters are left unset, so
+// the spec must be validated before it is created.
+func (e Experiment) Spec() (Spec, error) {
+	p := parser{}
+	selector := Selector{Mode: Mode(e.Mode), Value: e.Value, Podname: e.Podname}
+	schedule := Schedule{Duration: p.duration("duration", e.Duration), Cron: e.Cron}
+
+	var s Spec
+	switch e.Scenario {
+	case ScenarioPodFailure:
+		s = PodFailureSpec{Selector: selector, Schedule: schedule}
+	case ScenarioPodKill:
+		s = PodKillSpec{Selector: selector, Cron: e.Cron}
+	case ScenarioNetworkPartition:
+		s = NetworkPartitionSpec{
+			Selector:  selector,
+			Target:    Selector{Mode: Mode(e.Mode2), Value: e.Value2, Podname: e.Podname2},
+			Direction: e.Direction,
+			Schedule:  schedule,
+		}
+	case ScenarioNetworkLoss:
+		s = NetworkLossSpec{Selector: selector, Loss: p.float("loss", e.Loss), Correlation: p.float("correlation", e.Correlation), Schedule: schedule}
+	case ScenarioNetworkDelay:
+		s = NetworkDelaySpec{Selector: selector, Latency: p.duration("latency", e.Latency), Jitter: p.duration("jitter", e.Jitter), Correlation: p.float("correlation", e.Correlation), Schedule: schedule}
+	case ScenarioNetworkDuplicate:
+		s = NetworkDuplicateSpec{Selector: selector, Duplicate: p.float("duplicate", e.Duplicate), Correlation: p.float("correlation", e.Correlation), Schedule: schedule}
+	case ScenarioNetworkCorrupt:
+		s = NetworkCorruptSpec{Selector: selector, Corrupt: p.float("corrupt", e.Corrupt), Correlation: p.float("correlation", e.Correlation), Schedule: schedule}
+	case ScenarioStress:
+		s = StressSpec{Selector: selector, Workers: p.int("workers", e.Workers), CPULoad: p.int("cpu-load", e.CPULoad), MemorySize: e.MemorySize, Schedule: schedule}
+	case ScenarioIOLatency:
+		s = IOLatencySpec{Selector: selector, IOVolume: e.ioVolume(&p), Latency: p.duration("latency", e.Latency), Schedule: schedule}
+	case ScenarioIOFault:
+		s = IOFaultSpec{Selector: selector, IOVolume: e.ioVolume(&p), Errno: p.int("errno", e.Errno), Schedule: schedule}
+	case ScenarioTimeSkew:
+		s = TimeSkewSpec{Selector: selector, TimeOffset: p.duration("time-offset", e.TimeOffset), Schedule: schedule}
+	default:
+		return nil, fmt.Errorf("unknown scenario %q", e.Scenario)
+	}
+
+	if p.err != nil {
+		return nil, p.err
+	}
+	return s, nil
+}
+
+func (e Experiment) ioVolume(p *parser) IOVolume {
+	return IOVolume{VolumePath: e.VolumePath, Path: e.Path, Percent: p.int("percent", e.Percent)}
+}
+
+// parser parses experiment parameters and keeps the first error
+type parser struct {
+	err error
+}
+
+func (p *parser) duration(name, value string) (d time.Duration) {
+	if value == "" || p.err != nil {
+		return 0
+	}
+	if d, p.err = time.ParseDuration(value); p.err != nil {
+		p.err = fmt.Errorf("%s must be a duration like 15s, 5m or 1h, got %q", name, value)
+	}
+	return d
+}
+
+func (p *parser) float(name, value string) (v float64) {
+	if value == "" || p.err != nil {
+		return 0
+	}
+	if v, p.err = strconv.ParseFloat(value, 64); p.err != nil {
+		p.err = fmt.Errorf("%s must be a number, got %q", name, value)
+	}
+	return v
+}
+
+func (p *parser) int(name, value string) (v int) {
+	if value == "" || p.err != nil {
+		return 0
+	}
+	if v, p.err = strconv.Atoi(value); p.err != nil {
+		p.err = fmt.Errorf("%s must be an integer, got %q", name, value)
+	}
+	return v
+}
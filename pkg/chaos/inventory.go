@@ -0,0 +1,177 @@
+package chaos
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/ethersphere/beekeeper/pkg/dynamick8s"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Labels of chaos objects created by beekeeper
+const (
+	labelManagedBy       = "app.kubernetes.io/managed-by"
+	labelManagedByValue  = "beekeeper"
+	labelTargetNamespace = "beekeeper.ethersphere.io/target-namespace"
+)
+
+// chaosResources are Chaos Mesh resources created by beekeeper
+var chaosResources = []schema.GroupVersionResource{
+	{Group: "pingcap.com", Version: "v1alpha1", Resource: "podchaos"},
+	{Group: "pingcap.com", Version: "v1alpha1", Resource: "networkchaos"},
+	{Group: "pingcap.com", Version: "v1alpha1", Resource: "stresschaos"},
+	{Group: "pingcap.com", Version: "v1alpha1", Resource: "iochaos"},
+	{Group: "pingcap.com", Version: "v1alpha1", Resource: "timechaos"},
+}
+
+// labeled labels chaos object as created by beekeeper for pods in the
+// namespace, so that it can be listed and cleaned up
+func labeled(object *unstructured.Unstructured, namespace string) *unstructured.Unstructured {
+	labels := object.GetLabels()
+	if labels == nil {
+		labels = make(map[string]string)
+	}
+	labels[labelManagedBy] = labelManagedByValue
+	labels[labelTargetNamespace] = namespace
+	object.SetLabels(labels)
+	return object
+}
+
+// selectorFor returns label selector of chaos objects created by beekeeper,
+// for pods in the namespace if it is set
+func selectorFor(namespace string) string {
+	s := labelManagedBy + "=" + labelManagedByValue
+	if namespace != "" {
+		s += "," + labelTargetNamespace + "=" + namespace
+	}
+	return s
+}
+
+// Condition represents condition of the chaos experiment
+type Condition struct {
+	Type   string
+	Status string
+}
+
+// Status represents status of the chaos experiment
+type Status struct {
+	Name            string
+	Kind            string
+	Action          string
+	TargetNamespace string
+	Phase           string
+	FailedMessage   string
+	Conditions      []Condition
+	Created         time.Time
+}
+
+// newStatus reads status of the chaos object
+func newStatus(object unstructured.Unstructured) Status {
+	s := Status{
+		Name:            object.GetName(),
+		Kind:            object.GetKind(),
+		TargetNamespace: object.GetLabels()[labelTargetNamespace],
+		Created:         object.GetCreationTimestamp().Time,
+	}
+	s.Action, _, _ = unstructured.NestedString(object.Object, "spec", "action")
+	s.Phase, _, _ = unstructured.NestedString(object.Object, "status", "experiment", "phase")
+	s.FailedMessage, _, _ = unstructured.NestedString(object.Object, "status", "failedMessage")
+
+	conditions, _, _ := unstructured.NestedSlice(object.Object, "status", "conditions")
+	for _, c := range conditions {
+		m, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		t, _, _ := unstructured.NestedString(m, "type")
+		v, _, _ := unstructured.NestedString(m, "status")
+		s.Conditions = append(s.Conditions, Condition{Type: t, Status: v})
+	}
+
+	return s
+}
+
+// List returns statuses of chaos experiments created by beekeeper, for pods
+// in the namespace if it is set
+func List(ctx context.Context, kubeconfig, namespace string) (statuses []Status, err error) {
+	for _, r := range chaosResources {
+		client, err := dynamick8s.NewClient(kubeconfig, chaosNamespace, r)
+		if err != nil {
+			return nil, err
+		}
+
+		objects, err := client.List(ctx, selectorFor(namespace))
+		if err != nil {
+			return nil, fmt.Errorf("list %s: %w", r.Resource, err)
+		}
+		for _, o := range objects.Items {
+			statuses = append(statuses, newStatus(o))
+		}
+	}
+
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Name < statuses[j].Name
+	})
+	return statuses, nil
+}
+
+// GetStatus returns status of the chaos experiment by its name
+func GetStatus(ctx context.Context, kubeconfig, name string) (Status, error) {
+	for _, r := range chaosResources {
+		client, err := dynamick8s.NewClient(kubeconfig, chaosNamespace, r)
+		if err != nil {
+			return Status{}, err
+		}
+
+		object, err := client.Get(ctx, name)
+		if err != nil {
+			if isNotFound(err) {
+				continue
+			}
+			return Status{}, fmt.Errorf("get %s %s: %w", r.Resource, name, err)
+		}
+		if object.GetLabels()[labelManagedBy] != labelManagedByValue {
+			return Status{}, fmt.Errorf("chaos experiment %s is not created by beekeeper", name)
+		}
+		return newStatus(*object), nil
+	}
+
+	return Status{}, fmt.Errorf("chaos experiment %s not found", name)
+}
+
+// Cleanup deletes all chaos experiments created by beekeeper, for pods in the
+// namespace if it is set, and returns names of deleted experiments
+func Cleanup(ctx context.Context, kubeconfig, namespace string) (deleted []string, err error) {
+	for _, r := range chaosResources {
+		client, err := dynamick8s.NewClient(kubeconfig, chaosNamespace, r)
+		if err != nil {
+			return deleted, err
+		}
+
+		objects, err := client.List(ctx, selectorFor(namespace))
+		if err != nil {
+			return deleted, fmt.Errorf("list %s: %w", r.Resource, err)
+		}
+		for _, o := range objects.Items {
+			if err := client.Delete(ctx, o.GetName()); err != nil {
+				return deleted, fmt.Errorf("delete %s %s: %w", r.Resource, o.GetName(), err)
+			}
+			deleted = append(deleted, o.GetName())
+		}
+	}
+
+	return deleted, nil
+}
+
+// isNotFound reports whether err wraps Kubernetes API not found error, as
+// dynamick8s client wraps API errors
+func isNotFound(err error) bool {
+	var status apierrors.APIStatus
+	return errors.As(err, &status) && status.Status().Reason == metav1.StatusReasonNotFound
+}
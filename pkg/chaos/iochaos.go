@@ -0,0 +1,125 @@
+package chaos
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// compile check whether specs implement interface
+var (
+	_ Spec = IOLatencySpec{}
+	_ Spec = IOFaultSpec{}
+)
+
+// DefaultVolumePath is the mount path of the bee data directory
+const DefaultVolumePath = "/home/bee/.bee"
+
+// IOVolume selects files with injected IO chaos. Files are matched by the
+// path glob, or all files in the volume if the path is not set.
+type IOVolume struct {
+	VolumePath string
+	Path       string
+	Percent    int // percentage of injected IO operations
+}
+
+// Validate validates volume path and percentage of injected IO operations
+func (v IOVolume) Validate() error {
+	if !strings.HasPrefix(v.VolumePath, "/") {
+		return fmt.Errorf("volume path must be an absolute path, got %q", v.VolumePath)
+	}
+	if v.Percent < 1 || v.Percent > 100 {
+		return fmt.Errorf("percent must be a percentage between 1 and 100, got %d", v.Percent)
+	}
+	return nil
+}
+
+func (v IOVolume) spec() map[string]interface{} {
+	spec := map[string]interface{}{
+		"volumePath": v.VolumePath,
+		"percent":    int64(v.Percent),
+	}
+	if v.Path != "" {
+		spec["path"] = v.Path
+	}
+	return spec
+}
+
+// IOLatencySpec delays IO operations of selected pods
+type IOLatencySpec struct {
+	Selector
+	IOVolume
+	Latency time.Duration
+	Schedule
+}
+
+// Name returns name of the chaos object
+func (s IOLatencySpec) Name() string {
+	return "io-latency-" + string(s.Mode) + "-" + s.podname()
+}
+
+// Validate validates the specification
+func (s IOLatencySpec) Validate() error {
+	if err := s.Selector.Validate(); err != nil {
+		return err
+	}
+	if err := s.IOVolume.Validate(); err != nil {
+		return err
+	}
+	if s.Latency <= 0 {
+		return fmt.Errorf("latency must be positive, got %s", s.Latency)
+	}
+	return s.Schedule.Validate()
+}
+
+func (s IOLatencySpec) resource() schema.GroupVersionResource {
+	return resource("iochaos")
+}
+
+func (s IOLatencySpec) object(namespace string) *unstructured.Unstructured {
+	return newObject("IoChaos", s.Name(), s.Schedule.set(merge(merge(s.Selector.spec(namespace), s.IOVolume.spec()), map[string]interface{}{
+		"action": "latency",
+		"delay":  s.Latency.String(),
+	})))
+}
+
+// IOFaultSpec fails IO operations of selected pods with the error number
+type IOFaultSpec struct {
+	Selector
+	IOVolume
+	Errno int
+	Schedule
+}
+
+// Name returns name of the chaos object
+func (s IOFaultSpec) Name() string {
+	return "io-fault-" + string(s.Mode) + "-" + s.podname()
+}
+
+// Validate validates the specification
+func (s IOFaultSpec) Validate() error {
+	if err := s.Selector.Validate(); err != nil {
+		return err
+	}
+	if err := s.IOVolume.Validate(); err != nil {
+		return err
+	}
+	if s.Errno < 1 {
+		return fmt.Errorf("errno must be a positive error number, got %d", s.Errno)
+	}
+	return s.Schedule.Validate()
+}
+
+func (s IOFaultSpec) resource() schema.GroupVersionResource {
+	return resource("iochaos")
+}
+
+func (s IOFaultSpec) object(namespace string) *unstructured.Unstructured {
+	return newObject("IoChaos", s.Name(), s.Schedule.set(merge(merge(s.Selector.spec(namespace), s.IOVolume.spec()), map[string]interface{}{
+		"action": "fault",
+		"errno":  int64(s.Errno),
+	})))
+}
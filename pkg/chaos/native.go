@@ -0,0 +1,360 @@
+package chaos
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethersphere/beekeeper/pkg/k8s"
+)
+
+// compile check whether Native implements interface
+var _ Backend = (*Native)(nil)
+
+// Native is a backend that injects faults with the Kubernetes API only, it
+// does not require Chaos Mesh. It supports pod kill scenario, which deletes
+// random pods, and pod failure scenario, which scales StatefulSets of random
+// pods to zero and restores them. Scheduled experiments are run by the
+// process that created them, so they stop when the process exits.
+type Native struct {
+	k8s       *k8s.Client
+	namespace string
+
+	mu      sync.Mutex
+	rnd     *rand.Rand
+	running map[string]*nativeRun
+}
+
+// nativeRun represents experiment running in the process
+type nativeRun struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	mu     sync.Mutex
+	scaled map[string]int32 // original replicas of StatefulSets scaled to zero
+}
+
+// NewNative returns native backend that injects faults into pods in the
+// namespace
+func NewNative(k8sClient *k8s.Client, namespace string) *Native {
+	return &Native{
+		k8s:       k8sClient,
+		namespace: namespace,
+		rnd:       rand.New(rand.NewSource(time.Now().UnixNano())),
+		running:   make(map[string]*nativeRun),
+	}
+}
+
+// Check checks that Kubernetes client is set
+func (n *Native) Check(ctx context.Context) error {
+	if n.k8s == nil {
+		return fmt.Errorf("native chaos backend: kubernetes client not set")
+	}
+	return nil
+}
+
+// Create injects the first fault of the experiment and, if the experiment is
+// scheduled, keeps injecting faults at the cron interval until it is deleted
+func (n *Native) Create(ctx context.Context, s Spec) (err error) {
+	if err := s.Validate(); err != nil {
+		return fmt.Errorf("chaos experiment %s: %w", s.Name(), err)
+	}
+	f, err := newNativeFault(s)
+	if err != nil {
+		return err
+	}
+
+	n.mu.Lock()
+	if _, ok := n.running[s.Name()]; ok {
+		n.mu.Unlock()
+		return fmt.Errorf("chaos experiment %s is already running", s.Name())
+	}
+	r := &nativeRun{done: make(chan struct{}), scaled: make(map[string]int32)}
+	n.running[s.Name()] = r
+	n.mu.Unlock()
+
+	if err := n.inject(ctx, f, r); err != nil {
+		if rErr := n.restore(context.Background(), r); rErr != nil {
+			fmt.Printf("chaos experiment %s: %v\n", s.Name(), rErr)
+		}
+		n.mu.Lock()
+		delete(n.running, s.Name())
+		n.mu.Unlock()
+		return err
+	}
+
+	if f.interval == 0 {
+		r.cancel = func() {}
+		close(r.done)
+		return nil
+	}
+
+	rCtx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+	go func() {
+		defer close(r.done)
+
+		ticker := time.NewTicker(f.interval)
+		defer ticker.Stop()
+		for {
+			if !f.kill {
+				select {
+				case <-time.After(f.duration):
+				case <-rCtx.Done():
+					return
+				}
+				if err := n.restore(rCtx, r); err != nil {
+					fmt.Printf("chaos experiment %s: %v\n", s.Name(), err)
+				}
+			}
+
+			select {
+			case <-ticker.C:
+			case <-rCtx.Done():
+				return
+			}
+			if err := n.inject(rCtx, f, r); err != nil {
+				fmt.Printf("chaos experiment %s: %v\n", s.Name(), err)
+			}
+		}
+	}()
+
+	return nil
+}
+
+// Update restarts the experiment with the new spec
+func (n *Native) Update(ctx context.Context, s Spec) error {
+	if _, err := newNativeFault(s); err != nil {
+		return err
+	}
+	if err := n.Delete(ctx, s); err != nil {
+		return err
+	}
+	return n.Create(ctx, s)
+}
+
+// Delete stops the experiment and restores StatefulSets scaled to zero. If
+// the pod failure experiment was created by another process, StatefulSets
+// selected by the experiment that have no replicas are scaled to one replica.
+func (n *Native) Delete(ctx context.Context, s Spec) error {
+	f, err := newNativeFault(s)
+	if err != nil {
+		return err
+	}
+
+	n.mu.Lock()
+	r, ok := n.running[s.Name()]
+	delete(n.running, s.Name())
+	n.mu.Unlock()
+
+	if ok {
+		r.cancel()
+		<-r.done
+		return n.restore(ctx, r)
+	}
+
+	if f.kill {
+		return nil
+	}
+
+	statefulSets, err := n.statefulSets(ctx, f.selector)
+	if err != nil {
+		return err
+	}
+	for _, s := range statefulSets {
+		replicas, err := n.k8s.StatefulSet.Replicas(ctx, s, n.namespace)
+		if err != nil {
+			return err
+		}
+		if replicas > 0 {
+			continue
+		}
+		if err := n.k8s.StatefulSet.Scale(ctx, s, n.namespace, 1); err != nil {
+			return err
+		}
+		fmt.Printf("statefulset %s restored\n", s)
+	}
+
+	return nil
+}
+
+// WaitInjected waits until StatefulSets scaled to zero have no ready
+// replicas, pods are killed synchronously on creation
+func (n *Native) WaitInjected(ctx context.Context, s Spec) error {
+	n.mu.Lock()
+	r, ok := n.running[s.Name()]
+	n.mu.Unlock()
+	if !ok {
+		return fmt.Errorf("chaos experiment %s is not running", s.Name())
+	}
+
+	r.mu.Lock()
+	statefulSets := make([]string, 0, len(r.scaled))
+	for s := range r.scaled {
+		statefulSets = append(statefulSets, s)
+	}
+	r.mu.Unlock()
+
+	for _, s := range statefulSets {
+		for {
+			ready, err := n.k8s.StatefulSet.ReadyReplicas(ctx, s, n.namespace)
+			if err != nil {
+				return err
+			}
+			if ready == 0 {
+				break
+			}
+
+			select {
+			case <-time.After(time.Second):
+			case <-ctx.Done():
+				return fmt.Errorf("waiting for statefulset %s to scale down: %w", s, ctx.Err())
+			}
+		}
+	}
+
+	return nil
+}
+
+// inject kills random pods or scales random StatefulSets to zero
+func (n *Native) inject(ctx context.Context, f nativeFault, r *nativeRun) error {
+	if f.kill {
+		pods, err := n.k8s.Pods.List(ctx, n.namespace, f.selector.labelSelector())
+		if err != nil {
+			return err
+		}
+		for _, p := range n.pick(pods, f.selector) {
+			if err := n.k8s.Pods.Delete(ctx, p, n.namespace); err != nil {
+				return err
+			}
+			fmt.Printf("pod %s killed\n", p)
+		}
+		return nil
+	}
+
+	statefulSets, err := n.statefulSets(ctx, f.selector)
+	if err != nil {
+		return err
+	}
+	for _, s := range n.pick(statefulSets, f.selector) {
+		replicas, err := n.k8s.StatefulSet.Replicas(ctx, s, n.namespace)
+		if err != nil {
+			return err
+		}
+		if replicas == 0 {
+			continue
+		}
+
+		r.mu.Lock()
+		r.scaled[s] = replicas
+		r.mu.Unlock()
+		if err := n.k8s.StatefulSet.Scale(ctx, s, n.namespace, 0); err != nil {
+			return err
+		}
+		fmt.Printf("statefulset %s scaled to zero\n", s)
+	}
+
+	return nil
+}
+
+// restore scales StatefulSets back to their original replicas
+func (n *Native) restore(ctx context.Context, r *nativeRun) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for s, replicas := range r.scaled {
+		if err := n.k8s.StatefulSet.Scale(ctx, s, n.namespace, replicas); err != nil {
+			return err
+		}
+		delete(r.scaled, s)
+		fmt.Printf("statefulset %s restored\n", s)
+	}
+
+	return nil
+}
+
+// statefulSets returns StatefulSets selected by the experiment; StatefulSet of
+// a pod is named as the pod without its ordinal suffix
+func (n *Native) statefulSets(ctx context.Context, sel Selector) ([]string, error) {
+	if podname := sel.podname(); podname != "bee" {
+		i := strings.LastIndex(podname, "-")
+		if i < 0 {
+			return nil, fmt.Errorf("pod %s is not a statefulset pod", podname)
+		}
+		return []string{podname[:i]}, nil
+	}
+
+	return n.k8s.StatefulSet.List(ctx, n.namespace, sel.labelSelector())
+}
+
+// pick randomly picks names according to the selector mode and value
+func (n *Native) pick(names []string, sel Selector) []string {
+	names = append([]string(nil), names...)
+	sort.Strings(names)
+
+	v, _ := strconv.Atoi(sel.Value)
+	count := 0
+	switch sel.Mode {
+	case ModeOne:
+		count = 1
+	case ModeAll:
+		count = len(names)
+	case ModeFixed:
+		count = v
+	case ModeFixedPercent:
+		count = int(math.Max(1, math.Floor(float64(len(names)*v)/100)))
+	case ModeRandomMaxPercent:
+		max := int(math.Max(1, math.Floor(float64(len(names)*v)/100)))
+		n.mu.Lock()
+		count = n.rnd.Intn(max) + 1
+		n.mu.Unlock()
+	}
+	if count > len(names) {
+		count = len(names)
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	n.rnd.Shuffle(len(names), func(i, j int) { names[i], names[j] = names[j], names[i] })
+	return names[:count]
+}
+
+// nativeFault represents fault injected by native backend, it kills pods or
+// scales their StatefulSets to zero, once or at every interval
+type nativeFault struct {
+	selector Selector
+	kill     bool
+	interval time.Duration
+	duration time.Duration
+}
+
+// newNativeFault returns fault of the spec, native backend supports only pod
+// kill and pod failure specs with interval crons
+func newNativeFault(s Spec) (f nativeFault, err error) {
+	var cron string
+	switch s := s.(type) {
+	case PodKillSpec:
+		f = nativeFault{selector: s.Selector, kill: true}
+		cron = s.Cron
+	case PodFailureSpec:
+		f = nativeFault{selector: s.Selector, duration: s.Duration}
+		cron = s.Cron
+	default:
+		return nativeFault{}, fmt.Errorf("%w: native: %s", ErrScenarioNotSupported, s.Name())
+	}
+
+	if cron != "" {
+		var ok bool
+		if f.interval, ok = every(cron); !ok {
+			return nativeFault{}, fmt.Errorf("%w: native: %s: cron must be an interval like 5m, got %q", ErrScenarioNotSupported, s.Name(), cron)
+		}
+	}
+	return f, nil
+}
@@ -1,177 +1,236 @@
 package chaos
 
-import "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
-
-func networkPartition(mode1 string, value1 string, mode2 string, value2 string, namespace string, label1 string, podname1 string, label2 string, podname2 string, direction string, duration string, cron string) *unstructured.Unstructured {
-	return &unstructured.Unstructured{
-		Object: map[string]interface{}{
-			"apiVersion": "pingcap.com/v1alpha1",
-			"kind":       "NetworkChaos",
-			"metadata": map[string]interface{}{
-				"name":      "network-partition-" + mode1 + "-" + podname1,
-				"namespace": "chaos-testing",
-			},
-			"spec": map[string]interface{}{
-				"action":   "partition",
-				"mode":     mode1,
-				"value":    value1,
-				"duration": duration,
-				"selector": map[string]interface{}{
-					"namespaces": []string{
-						namespace},
-					"labelSelectors": map[string]interface{}{
-						label1: podname1,
-					},
-				},
-				"direction": direction,
-				"target": map[string]interface{}{
-					"selector": map[string]interface{}{
-						"namespaces": []string{
-							namespace},
-						"labelSelectors": map[string]interface{}{
-							label2: podname2,
-						},
-					},
-					"mode":  mode2,
-					"value": value2,
-					"scheduler": map[string]interface{}{
-						"cron": "@every " + cron,
-					},
-				},
-			},
-		},
+import (
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// compile check whether specs implement interface
+var (
+	_ Spec = NetworkPartitionSpec{}
+	_ Spec = NetworkLossSpec{}
+	_ Spec = NetworkDelaySpec{}
+	_ Spec = NetworkDuplicateSpec{}
+	_ Spec = NetworkCorruptSpec{}
+)
+
+// Directions of network partition
+const (
+	DirectionFrom = "from"
+	DirectionTo   = "to"
+	DirectionBoth = "both"
+)
+
+// NetworkPartitionSpec partitions network between selected pods and target
+// pods
+type NetworkPartitionSpec struct {
+	Selector
+	Target    Selector
+	Direction string
+	Schedule
+}
+
+// Name returns name of the chaos object
+func (s NetworkPartitionSpec) Name() string {
+	return "network-partition-" + string(s.Mode) + "-" + s.podname()
+}
+
+// Validate validates the specification
+func (s NetworkPartitionSpec) Validate() error {
+	if err := s.Selector.Validate(); err != nil {
+		return err
+	}
+	if err := s.Target.Validate(); err != nil {
+		return fmt.Errorf("target: %w", err)
 	}
+	switch s.Direction {
+	case DirectionFrom, DirectionTo, DirectionBoth:
+	default:
+		return fmt.Errorf("unknown direction %q, must be one of from, to, both", s.Direction)
+	}
+	return s.Schedule.Validate()
 }
 
-func networkLoss(mode string, value string, namespace string, label string, podname string, loss string, correlation string, duration string, cron string) *unstructured.Unstructured {
-	return &unstructured.Unstructured{
-		Object: map[string]interface{}{
-			"apiVersion": "pingcap.com/v1alpha1",
-			"kind":       "NetworkChaos",
-			"metadata": map[string]interface{}{
-				"name":      "network-loss-" + mode + "-" + podname,
-				"namespace": "chaos-testing",
-			},
-			"spec": map[string]interface{}{
-				"action":   "loss",
-				"mode":     mode,
-				"value":    value,
-				"duration": duration,
-				"selector": map[string]interface{}{
-					"namespaces": []string{
-						namespace},
-					"labelSelectors": map[string]interface{}{
-						label: podname,
-					},
-				},
-				"loss": map[string]interface{}{
-					"loss":        loss,
-					"correlation": correlation,
-				},
-				"scheduler": map[string]interface{}{
-					"cron": "@every " + cron,
-				},
-			},
-		},
+func (s NetworkPartitionSpec) resource() schema.GroupVersionResource {
+	return resource("networkchaos")
+}
+
+func (s NetworkPartitionSpec) object(namespace string) *unstructured.Unstructured {
+	return newObject("NetworkChaos", s.Name(), s.Schedule.set(merge(s.Selector.spec(namespace), map[string]interface{}{
+		"action":    "partition",
+		"direction": s.Direction,
+		"target":    s.Target.spec(namespace),
+	})))
+}
+
+// NetworkLossSpec drops percentage of packets of selected pods
+type NetworkLossSpec struct {
+	Selector
+	Loss        float64
+	Correlation float64
+	Schedule
+}
+
+// Name returns name of the chaos object
+func (s NetworkLossSpec) Name() string {
+	return "network-loss-" + string(s.Mode) + "-" + s.podname()
+}
+
+// Validate validates the specification
+func (s NetworkLossSpec) Validate() error {
+	if err := s.Selector.Validate(); err != nil {
+		return err
+	}
+	if err := validateRate("loss", s.Loss); err != nil {
+		return err
+	}
+	if err := validatePercentage("correlation", s.Correlation); err != nil {
+		return err
 	}
+	return s.Schedule.Validate()
 }
 
-func networkDelay(mode string, value string, namespace string, label string, podname string, latency string, correlation string, jitter string, duration string, cron string) *unstructured.Unstructured {
-	return &unstructured.Unstructured{
-		Object: map[string]interface{}{
-			"apiVersion": "pingcap.com/v1alpha1",
-			"kind":       "NetworkChaos",
-			"metadata": map[string]interface{}{
-				"name":      "network-delay-" + mode + "-" + podname,
-				"namespace": "chaos-testing",
-			},
-			"spec": map[string]interface{}{
-				"action":   "delay",
-				"mode":     mode,
-				"value":    value,
-				"duration": duration,
-				"selector": map[string]interface{}{
-					"namespaces": []string{
-						namespace},
-					"labelSelectors": map[string]interface{}{
-						label: podname,
-					},
-				},
-				"delay": map[string]interface{}{
-					"latency":     latency,
-					"correlation": correlation,
-					"jitter":      jitter,
-				},
-				"scheduler": map[string]interface{}{
-					"cron": "@every " + cron,
-				},
-			},
+func (s NetworkLossSpec) resource() schema.GroupVersionResource {
+	return resource("networkchaos")
+}
+
+func (s NetworkLossSpec) object(namespace string) *unstructured.Unstructured {
+	return newObject("NetworkChaos", s.Name(), s.Schedule.set(merge(s.Selector.spec(namespace), map[string]interface{}{
+		"action": "loss",
+		"loss": map[string]interface{}{
+			"loss":        formatFloat(s.Loss),
+			"correlation": formatFloat(s.Correlation),
 		},
+	})))
+}
+
+// NetworkDelaySpec delays packets of selected pods
+type NetworkDelaySpec struct {
+	Selector
+	Latency     time.Duration
+	Jitter      time.Duration
+	Correlation float64
+	Schedule
+}
+
+// Name returns name of the chaos object
+func (s NetworkDelaySpec) Name() string {
+	return "network-delay-" + string(s.Mode) + "-" + s.podname()
+}
+
+// Validate validates the specification
+func (s NetworkDelaySpec) Validate() error {
+	if err := s.Selector.Validate(); err != nil {
+		return err
+	}
+	if s.Latency <= 0 {
+		return fmt.Errorf("latency must be positive, got %s", s.Latency)
+	}
+	if s.Jitter < 0 {
+		return fmt.Errorf("jitter must not be negative, got %s", s.Jitter)
+	}
+	if err := validatePercentage("correlation", s.Correlation); err != nil {
+		return err
 	}
+	return s.Schedule.Validate()
 }
 
-func networkDuplicate(mode string, value string, namespace string, label string, podname string, duplicate string, correlation string, duration string, cron string) *unstructured.Unstructured {
-	return &unstructured.Unstructured{
-		Object: map[string]interface{}{
-			"apiVersion": "pingcap.com/v1alpha1",
-			"kind":       "NetworkChaos",
-			"metadata": map[string]interface{}{
-				"name":      "network-duplicate-" + mode + "-" + podname,
-				"namespace": "chaos-testing",
-			},
-			"spec": map[string]interface{}{
-				"action":   "duplicate",
-				"mode":     mode,
-				"value":    value,
-				"duration": duration,
-				"selector": map[string]interface{}{
-					"namespaces": []string{
-						namespace},
-					"labelSelectors": map[string]interface{}{
-						label: podname,
-					},
-				},
-				"duplicate": map[string]interface{}{
-					"duplicate":   duplicate,
-					"correlation": correlation,
-				},
-				"scheduler": map[string]interface{}{
-					"cron": "@every " + cron,
-				},
-			},
+func (s NetworkDelaySpec) resource() schema.GroupVersionResource {
+	return resource("networkchaos")
+}
+
+func (s NetworkDelaySpec) object(namespace string) *unstructured.Unstructured {
+	return newObject("NetworkChaos", s.Name(), s.Schedule.set(merge(s.Selector.spec(namespace), map[string]interface{}{
+		"action": "delay",
+		"delay": map[string]interface{}{
+			"latency":     s.Latency.String(),
+			"correlation": formatFloat(s.Correlation),
+			"jitter":      s.Jitter.String(),
 		},
+	})))
+}
+
+// NetworkDuplicateSpec duplicates percentage of packets of selected pods
+type NetworkDuplicateSpec struct {
+	Selector
+	Duplicate   float64
+	Correlation float64
+	Schedule
+}
+
+// Name returns name of the chaos object
+func (s NetworkDuplicateSpec) Name() string {
+	return "network-duplicate-" + string(s.Mode) + "-" + s.podname()
+}
+
+// Validate validates the specification
+func (s NetworkDuplicateSpec) Validate() error {
+	if err := s.Selector.Validate(); err != nil {
+		return err
 	}
+	if err := validateRate("duplicate", s.Duplicate); err != nil {
+		return err
+	}
+	if err := validatePercentage("correlation", s.Correlation); err != nil {
+		return err
+	}
+	return s.Schedule.Validate()
 }
 
-func networkCorrupt(mode string, value string, namespace string, label string, podname string, corrupt string, correlation string, duration string, cron string) *unstructured.Unstructured {
-	return &unstructured.Unstructured{
-		Object: map[string]interface{}{
-			"apiVersion": "pingcap.com/v1alpha1",
-			"kind":       "NetworkChaos",
-			"metadata": map[string]interface{}{
-				"name":      "network-corrupt-" + mode + "-" + podname,
-				"namespace": "chaos-testing",
-			},
-			"spec": map[string]interface{}{
-				"action":   "corrupt",
-				"mode":     mode,
-				"value":    value,
-				"duration": duration,
-				"selector": map[string]interface{}{
-					"namespaces": []string{
-						namespace},
-					"labelSelectors": map[string]interface{}{
-						label: podname,
-					},
-				},
-				"corrupt": map[string]interface{}{
-					"corrupt":     corrupt,
-					"correlation": correlation,
-				},
-				"scheduler": map[string]interface{}{
-					"cron": "@every " + cron,
-				},
-			},
+func (s NetworkDuplicateSpec) resource() schema.GroupVersionResource {
+	return resource("networkchaos")
+}
+
+func (s NetworkDuplicateSpec) object(namespace string) *unstructured.Unstructured {
+	return newObject("NetworkChaos", s.Name(), s.Schedule.set(merge(s.Selector.spec(namespace), map[string]interface{}{
+		"action": "duplicate",
+		"duplicate": map[string]interface{}{
+			"duplicate":   formatFloat(s.Duplicate),
+			"correlation": formatFloat(s.Correlation),
 		},
+	})))
+}
+
+// NetworkCorruptSpec corrupts percentage of packets of selected pods
+type NetworkCorruptSpec struct {
+	Selector
+	Corrupt     float64
+	Correlation float64
+	Schedule
+}
+
+// Name returns name of the chaos object
+func (s NetworkCorruptSpec) Name() string {
+	return "network-corrupt-" + string(s.Mode) + "-" + s.podname()
+}
+
+// Validate validates the specification
+func (s NetworkCorruptSpec) Validate() error {
+	if err := s.Selector.Validate(); err != nil {
+		return err
 	}
+	if err := validateRate("corrupt", s.Corrupt); err != nil {
+		return err
+	}
+	if err := validatePercentage("correlation", s.Correlation); err != nil {
+		return err
+	}
+	return s.Schedule.Validate()
+}
+
+func (s NetworkCorruptSpec) resource() schema.GroupVersionResource {
+	return resource("networkchaos")
+}
+
+func (s NetworkCorruptSpec) object(namespace string) *unstructured.Unstructured {
+	return newObject("NetworkChaos", s.Name(), s.Schedule.set(merge(s.Selector.spec(namespace), map[string]interface{}{
+		"action": "corrupt",
+		"corrupt": map[string]interface{}{
+			"corrupt":     formatFloat(s.Corrupt),
+			"correlation": formatFloat(s.Correlation),
+		},
+	})))
 }
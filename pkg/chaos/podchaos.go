@@ -1,85 +1,79 @@
 package chaos
 
-import "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
 
-func podFailure(mode string, value string, namespace string, label string, podname string, duration string, cron string) *unstructured.Unstructured {
-	return &unstructured.Unstructured{
-		Object: map[string]interface{}{
-			"apiVersion": "pingcap.com/v1alpha1",
-			"kind":       "PodChaos",
-			"metadata": map[string]interface{}{
-				"name":      "pod-failure-" + mode + "-" + podname,
-				"namespace": "chaos-testing",
-			},
-			"spec": map[string]interface{}{
-				"action":   "pod-failure",
-				"mode":     mode,
-				"value":    value,
-				"duration": duration,
-				"selector": map[string]interface{}{
-					"namespaces": []string{
-						namespace},
-					"labelSelectors": map[string]interface{}{
-						label: podname,
-					},
-				},
-				"scheduler": map[string]interface{}{
-					"cron": "@every " + cron,
-				},
-			},
-		},
+// compile check whether specs implement interface
+var (
+	_ Spec = PodFailureSpec{}
+	_ Spec = PodKillSpec{}
+)
+
+// PodFailureSpec makes selected pods unavailable
+type PodFailureSpec struct {
+	Selector
+	Schedule
+}
+
+// Name returns name of the chaos object
+func (s PodFailureSpec) Name() string {
+	return "pod-failure-" + string(s.Mode) + "-" + s.podname()
+}
+
+// Validate validates the specification
+func (s PodFailureSpec) Validate() error {
+	if err := s.Selector.Validate(); err != nil {
+		return err
 	}
+	return s.Schedule.Validate()
+}
+
+func (s PodFailureSpec) resource() schema.GroupVersionResource {
+	return resource("podchaos")
+}
+
+func (s PodFailureSpec) object(namespace string) *unstructured.Unstructured {
+	return newObject("PodChaos", s.Name(), s.Schedule.set(merge(s.Selector.spec(namespace), map[string]interface{}{
+		"action": "pod-failure",
+	})))
+}
+
+// PodKillSpec kills selected pods, once or at every cron time if cron is set
+type PodKillSpec struct {
+	Selector
+	Cron string
+}
+
+// Name returns name of the chaos object
+func (s PodKillSpec) Name() string {
+	return "pod-kill-" + string(s.Mode) + "-" + s.podname()
 }
 
-func podKillCron(mode string, value string, namespace string, label string, podname string, cron string) *unstructured.Unstructured {
-	return &unstructured.Unstructured{
-		Object: map[string]interface{}{
-			"apiVersion": "pingcap.com/v1alpha1",
-			"kind":       "PodChaos",
-			"metadata": map[string]interface{}{
-				"name":      "pod-kill-" + mode + "-" + podname,
-				"namespace": "chaos-testing",
-			},
-			"spec": map[string]interface{}{
-				"action": "pod-kill",
-				"mode":   mode,
-				"value":  value,
-				"selector": map[string]interface{}{
-					"namespaces": []string{
-						namespace},
-					"labelSelectors": map[string]interface{}{
-						label: podname,
-					},
-				},
-				"scheduler": map[string]interface{}{
-					"cron": "@every " + cron,
-				},
-			},
-		},
+// Validate validates the specification
+func (s PodKillSpec) Validate() error {
+	if err := s.Selector.Validate(); err != nil {
+		return err
+	}
+	if s.Cron == "" {
+		return nil
 	}
+	return validateCron(s.Cron)
+}
+
+func (s PodKillSpec) resource() schema.GroupVersionResource {
+	return resource("podchaos")
 }
 
-func podKill(mode string, value string, namespace string, label string, podname string) *unstructured.Unstructured {
-	return &unstructured.Unstructured{
-		Object: map[string]interface{}{
-			"apiVersion": "pingcap.com/v1alpha1",
-			"kind":       "PodChaos",
-			"metadata": map[string]interface{}{
-				"name":      "pod-kill-" + mode + "-" + podname,
-				"namespace": "chaos-testing",
-			},
-			"spec": map[string]interface{}{
-				"action": "pod-kill",
-				"mode":   mode,
-				"value":  value,
-				"selector": map[string]interface{}{
-					"namespaces": []string{
-						namespace},
-					"labelSelectors": map[string]interface{}{
-						label: podname,
-					},
-				},
-			},
-		},
+func (s PodKillSpec) object(namespace string) *unstructured.Unstructured {
+	spec := merge(s.Selector.spec(namespace), map[string]interface{}{
+		"action": "pod-kill",
+	})
+	if s.Cron != "" {
+		spec["scheduler"] = map[string]interface{}{
+			"cron": scheduler(s.Cron),
+		}
 	}
+	return newObject("PodChaos", s.Name(), spec)
 }
@@ -0,0 +1,257 @@
+package chaos
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// chaosNamespace is the namespace in which chaos objects are created
+const chaosNamespace = "chaos-testing"
+
+// Spec represents typed specification of a chaos experiment
+type Spec interface {
+	// Name returns name of the chaos object
+	Name() string
+	// Validate validates the specification
+	Validate() error
+	// resource returns Chaos Mesh resource of the chaos object
+	resource() schema.GroupVersionResource
+	// object returns chaos object that injects chaos into pods in the namespace
+	object(namespace string) *unstructured.Unstructured
+}
+
+// Mode defines which of the selected pods are injected with chaos
+type Mode string
+
+// Modes of chaos experiments
+const (
+	ModeOne              Mode = "one"
+	ModeAll              Mode = "all"
+	ModeFixed            Mode = "fixed"
+	ModeFixedPercent     Mode = "fixed-percent"
+	ModeRandomMaxPercent Mode = "random-max-percent"
+)
+
+// Selector selects pods injected with chaos. Podname bee selects all bee pods,
+// any other podname selects the pod with that name. Value is the number of
+// pods for fixed mode, percentage of pods for percent modes, and must be
+// empty for other modes.
+type Selector struct {
+	Mode    Mode
+	Value   string
+	Podname string
+}
+
+// Validate validates mode and value combination of the selector
+func (s Selector) Validate() error {
+	switch s.Mode {
+	case ModeOne, ModeAll:
+		if s.Value != "" {
+			return fmt.Errorf("mode %s: value must be empty, got %q", s.Mode, s.Value)
+		}
+	case ModeFixed:
+		v, err := strconv.Atoi(s.Value)
+		if err != nil || v < 1 {
+			return fmt.Errorf("mode %s: value must be a positive number of pods, got %q", s.Mode, s.Value)
+		}
+	case ModeFixedPercent, ModeRandomMaxPercent:
+		v, err := strconv.Atoi(s.Value)
+		if err != nil || v < 1 || v > 100 {
+			return fmt.Errorf("mode %s: value must be a percentage between 1 and 100, got %q", s.Mode, s.Value)
+		}
+	default:
+		return fmt.Errorf("unknown mode %q, must be one of one, all, fixed, fixed-percent, random-max-percent", s.Mode)
+	}
+
+	return nil
+}
+
+// podname returns podname of the selector, bee if it is not set
+func (s Selector) podname() string {
+	if s.Podname == "" {
+		return "bee"
+	}
+	return s.Podname
+}
+
+// label returns label that selects pods with the podname
+func (s Selector) label() string {
+	if s.podname() == "bee" {
+		return "app.kubernetes.io/name"
+	}
+	return "statefulset.kubernetes.io/pod-name"
+}
+
+// labelSelector returns label selector of pods with the podname
+func (s Selector) labelSelector() string {
+	return s.label() + "=" + s.podname()
+}
+
+// spec returns chaos spec fields of the selector for pods in the namespace
+func (s Selector) spec(namespace string) map[string]interface{} {
+	return map[string]interface{}{
+		"mode":  string(s.Mode),
+		"value": s.Value,
+		"selector": map[string]interface{}{
+			"namespaces": []interface{}{namespace},
+			"labelSelectors": map[string]interface{}{
+				s.label(): s.podname(),
+			},
+		},
+	}
+}
+
+// Schedule defines when chaos is injected. Chaos with duration and cron is
+// injected for the duration at every cron time, chaos without them is
+// injected until it is deleted. Cron is an interval like 5m, @every 5m, a
+// descriptor like @hourly or a standard five field cron expression.
+type Schedule struct {
+	Duration time.Duration
+	Cron     string
+}
+
+var (
+	cronDescriptors = map[string]bool{"@yearly": true, "@annually": true, "@monthly": true, "@weekly": true, "@daily": true, "@midnight": true, "@hourly": true}
+	cronFieldRe     = regexp.MustCompile(`^(\*|\?|[0-9A-Za-z]+(-[0-9A-Za-z]+)?)(/[0-9]+)?(,(\*|[0-9A-Za-z]+(-[0-9A-Za-z]+)?)(/[0-9]+)?)*$`)
+)
+
+// Validate validates that duration and cron are both set or both empty and
+// that cron has valid syntax
+func (s Schedule) Validate() error {
+	if s.Duration < 0 {
+		return fmt.Errorf("duration must be positive, got %s", s.Duration)
+	}
+	if (s.Duration == 0) != (s.Cron == "") {
+		return fmt.Errorf("duration and cron must be either both set or both empty, got duration %s and cron %q", s.Duration, s.Cron)
+	}
+	if s.Cron == "" {
+		return nil
+	}
+	return validateCron(s.Cron)
+}
+
+// validateCron validates cron syntax
+func validateCron(cron string) error {
+	if _, ok := every(cron); ok {
+		return nil
+	}
+	if cronDescriptors[cron] {
+		return nil
+	}
+
+	fields := strings.Fields(cron)
+	if len(fields) != 5 {
+		return fmt.Errorf("cron must be an interval like 5m, @every 5m, a descriptor like @hourly or a five field cron expression, got %q", cron)
+	}
+	for _, f := range fields {
+		if !cronFieldRe.MatchString(f) {
+			return fmt.Errorf("cron %q: invalid field %q", cron, f)
+		}
+	}
+
+	return nil
+}
+
+// every returns interval of the interval cron
+func every(cron string) (time.Duration, bool) {
+	d, err := time.ParseDuration(strings.TrimSpace(strings.TrimPrefix(cron, "@every")))
+	if err != nil || d <= 0 {
+		return 0, false
+	}
+	return d, true
+}
+
+// scheduler returns Chaos Mesh scheduler cron
+func scheduler(cron string) string {
+	if _, err := time.ParseDuration(cron); err == nil {
+		return "@every " + cron
+	}
+	return cron
+}
+
+// set sets duration and scheduler of the chaos spec
+func (s Schedule) set(spec map[string]interface{}) map[string]interface{} {
+	if s.Duration > 0 {
+		spec["duration"] = s.Duration.String()
+	}
+	if s.Cron != "" {
+		spec["scheduler"] = map[string]interface{}{
+			"cron": scheduler(s.Cron),
+		}
+	}
+	return spec
+}
+
+// ObjectName returns name of the chaos object of the spec for pods in the
+// namespace. Chaos objects of all namespaces are created in the same chaos
+// namespace, so the name includes the target namespace, so that runs of the
+// same experiment against different namespaces do not collide.
+func ObjectName(s Spec, namespace string) string {
+	if namespace == "" {
+		return s.Name()
+	}
+	return s.Name() + "-" + namespace
+}
+
+// namespacedObject returns labeled chaos object of the spec for pods in the
+// namespace, named by ObjectName
+func namespacedObject(s Spec, namespace string) *unstructured.Unstructured {
+	object := labeled(s.object(namespace), namespace)
+	object.SetName(ObjectName(s, namespace))
+	return object
+}
+
+// newObject returns chaos object of the kind with the spec
+func newObject(kind, name string, spec map[string]interface{}) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "pingcap.com/v1alpha1",
+			"kind":       kind,
+			"metadata": map[string]interface{}{
+				"name":      name,
+				"namespace": chaosNamespace,
+			},
+			"spec": spec,
+		},
+	}
+}
+
+// merge adds fields to the spec
+func merge(spec map[string]interface{}, fields map[string]interface{}) map[string]interface{} {
+	for k, v := range fields {
+		spec[k] = v
+	}
+	return spec
+}
+
+// validatePercentage validates percentage value
+func validatePercentage(name string, v float64) error {
+	if v < 0 || v > 100 {
+		return fmt.Errorf("%s must be a percentage between 0 and 100, got %v", name, v)
+	}
+	return nil
+}
+
+// validateRate validates percentage of affected packets, which must be
+// greater than zero
+func validateRate(name string, v float64) error {
+	if v <= 0 || v > 100 {
+		return fmt.Errorf("%s must be a percentage greater than 0 and at most 100, got %v", name, v)
+	}
+	return nil
+}
+
+// formatFloat formats percentage value of the chaos spec
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+func resource(name string) schema.GroupVersionResource {
+	return schema.GroupVersionResource{Group: "pingcap.com", Version: "v1alpha1", Resource: name}
+}
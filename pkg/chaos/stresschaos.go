@@ -0,0 +1,74 @@
+package chaos
+
+import (
+	"fmt"
+	"regexp"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// compile check whether spec implements interface
+var _ Spec = StressSpec{}
+
+var memorySizeRe = regexp.MustCompile(`^[1-9][0-9]*(B|KB|MB|GB|KiB|MiB|GiB|%)$`)
+
+// StressSpec puts CPU and memory pressure on selected pods. At least one of
+// CPU load and memory size must be set. Memory size is in bytes with a unit
+// like 256MB, or a percentage of the total memory like 50%.
+type StressSpec struct {
+	Selector
+	Workers    int
+	CPULoad    int // percentage of CPU load per worker
+	MemorySize string
+	Schedule
+}
+
+// Name returns name of the chaos object
+func (s StressSpec) Name() string {
+	return "stress-" + string(s.Mode) + "-" + s.podname()
+}
+
+// Validate validates the specification
+func (s StressSpec) Validate() error {
+	if err := s.Selector.Validate(); err != nil {
+		return err
+	}
+	if s.Workers < 1 {
+		return fmt.Errorf("workers must be a positive number, got %d", s.Workers)
+	}
+	if s.CPULoad == 0 && s.MemorySize == "" {
+		return fmt.Errorf("at least one of cpu load and memory size must be set")
+	}
+	if s.CPULoad < 0 || s.CPULoad > 100 {
+		return fmt.Errorf("cpu load must be a percentage between 0 and 100, got %d", s.CPULoad)
+	}
+	if s.MemorySize != "" && !memorySizeRe.MatchString(s.MemorySize) {
+		return fmt.Errorf("memory size must be a size like 256MB or 1GB, or a percentage like 50%%, got %q", s.MemorySize)
+	}
+	return s.Schedule.Validate()
+}
+
+func (s StressSpec) resource() schema.GroupVersionResource {
+	return resource("stresschaos")
+}
+
+func (s StressSpec) object(namespace string) *unstructured.Unstructured {
+	stressors := map[string]interface{}{}
+	if s.CPULoad > 0 {
+		stressors["cpu"] = map[string]interface{}{
+			"workers": int64(s.Workers),
+			"load":    int64(s.CPULoad),
+		}
+	}
+	if s.MemorySize != "" {
+		stressors["memory"] = map[string]interface{}{
+			"workers": int64(s.Workers),
+			"size":    s.MemorySize,
+		}
+	}
+
+	return newObject("StressChaos", s.Name(), s.Schedule.set(merge(s.Selector.spec(namespace), map[string]interface{}{
+		"stressors": stressors,
+	})))
+}
@@ -0,0 +1,47 @@
+package chaos
+
+import (
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// compile check whether spec implements interface
+var _ Spec = TimeSkewSpec{}
+
+// TimeSkewSpec skews realtime clock of selected pods by the offset, which may
+// be negative
+type TimeSkewSpec struct {
+	Selector
+	TimeOffset time.Duration
+	Schedule
+}
+
+// Name returns name of the chaos object
+func (s TimeSkewSpec) Name() string {
+	return "time-skew-" + string(s.Mode) + "-" + s.podname()
+}
+
+// Validate validates the specification
+func (s TimeSkewSpec) Validate() error {
+	if err := s.Selector.Validate(); err != nil {
+		return err
+	}
+	if s.TimeOffset == 0 {
+		return fmt.Errorf("time offset must not be zero")
+	}
+	return s.Schedule.Validate()
+}
+
+func (s TimeSkewSpec) resource() schema.GroupVersionResource {
+	return resource("timechaos")
+}
+
+func (s TimeSkewSpec) object(namespace string) *unstructured.Unstructured {
+	return newObject("TimeChaos", s.Name(), s.Schedule.set(merge(s.Selector.spec(namespace), map[string]interface{}{
+		"timeOffset": s.TimeOffset.String(),
+		"clockIds":   []interface{}{"CLOCK_REALTIME"},
+	})))
+}
@@ -59,16 +59,19 @@ func Check(c *bee.Cluster, o Options, pusher *push.Pusher, pushMetrics bool) (er
 		file := bee.NewRandomFile(rnd, fmt.Sprintf("%s-%s", o.FileName, nodeName), o.FileSize)
 		client := c.NodeGroups()[ng].NodeClient(nodeName)
 
-		// add some buffer to ensure depth is enough
-		depth := 2 + bee.EstimatePostageBatchDepth(file.Size())
-		batchID, err := client.CreatePostageBatch(ctx, o.PostageAmount, depth, "test-label")
+		batchID, err := client.Batches().Get(ctx, bee.BatchOptions{
+			Amount: o.PostageAmount,
+			// add some buffer to ensure depth is enough
+			Depth:  2 + bee.EstimatePostageBatchDepth(file.Size()),
+			Label:  "test-label",
+			Chunks: bee.CalculateNumberOfChunks(file.Size(), false),
+			Wait:   o.PostageWait,
+		})
 		if err != nil {
-			return fmt.Errorf("node %s: created batched id %w", nodeName, err)
+			return fmt.Errorf("node %s: batch id %w", nodeName, err)
 		}
 
-		fmt.Printf("node %s: created batched id %s\n", nodeName, batchID)
-
-		time.Sleep(o.PostageWait)
+		fmt.Printf("node %s: batch id %s\n", nodeName, batchID)
 
 		if err := client.UploadFile(ctx, &file, api.UploadOptions{BatchID: batchID}); err != nil {
 			return fmt.Errorf("node %s: %w", nodeName, err)
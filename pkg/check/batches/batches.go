@@ -0,0 +1,178 @@
+package batches
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethersphere/beekeeper/pkg/bee"
+	"github.com/ethersphere/beekeeper/pkg/beeclient/debugapi"
+	"github.com/ethersphere/beekeeper/pkg/random"
+	"github.com/prometheus/client_golang/prometheus/push"
+	"github.com/prometheus/common/expfmt"
+)
+
+// Options represents batches check options
+type Options struct {
+	BatchCount     int
+	MetricsEnabled bool
+	MetricsPusher  *push.Pusher
+	Seed           int64
+	PostageAmount  int64
+	PostageDepth   uint64
+	PostageWait    time.Duration
+	Timeout        time.Duration
+	RetryDelay     time.Duration
+}
+
+var errBatchNotPropagated = errors.New("batch not propagated")
+
+// Check creates batches on random nodes and checks that all other nodes in the
+// cluster know each batch with the same depth, amount and bucket depth within
+// timeout. Nodes list only their own batches as stamps, so batches are looked
+// up in the batch store of every node, which holds batches of the whole
+// network.
+func Check(c *bee.Cluster, o Options) (err error) {
+	ctx := context.Background()
+	rnd := random.PseudoGenerator(o.Seed)
+	fmt.Println("batches: visibility check")
+	fmt.Printf("Seed: %d\n", o.Seed)
+
+	if o.MetricsEnabled {
+		o.MetricsPusher.Collector(propagationGauge)
+		o.MetricsPusher.Collector(propagationHistogram)
+		o.MetricsPusher.Format(expfmt.FmtText)
+	}
+
+	clients, err := c.NodesClients(ctx)
+	if err != nil {
+		return err
+	}
+	sortedNodes := c.NodeNames()
+
+	for i := 0; i < o.BatchCount; i++ {
+		creator := sortedNodes[rnd.Intn(len(sortedNodes))]
+		client := clients[creator]
+
+		batchID, err := client.CreatePostageBatch(ctx, o.PostageAmount, o.PostageDepth, "test-label")
+		if err != nil {
+			return fmt.Errorf("node %s: create batch: %w", creator, err)
+		}
+		created := time.Now()
+		fmt.Printf("node %s: created batch %s\n", creator, batchID)
+
+		if err := client.WaitPostageBatch(ctx, batchID, o.PostageWait); err != nil {
+			return fmt.Errorf("node %s: %w", creator, err)
+		}
+
+		want, err := globalBatch(ctx, client, batchID)
+		if err != nil {
+			return fmt.Errorf("node %s: %w", creator, err)
+		}
+
+		pending := make(map[string]struct{})
+		for _, n := range sortedNodes {
+			if n != creator {
+				pending[n] = struct{}{}
+			}
+		}
+
+		if err := waitPropagation(ctx, clients, pending, want, created, o); err != nil {
+			return fmt.Errorf("batch %s: %w", batchID, err)
+		}
+		fmt.Printf("batch %s known by all nodes\n", batchID)
+	}
+
+	fmt.Println("batches check completed successfully")
+	return
+}
+
+// waitPropagation waits until all pending nodes know the batch with the same
+// depth, amount and bucket depth as the creator node
+func waitPropagation(ctx context.Context, clients map[string]*bee.Client, pending map[string]struct{}, want debugapi.Batch, created time.Time, o Options) error {
+	ctx, cancel := context.WithTimeout(ctx, o.Timeout)
+	defer cancel()
+
+	var lastErr error
+	for {
+		for n := range pending {
+			if err := checkBatch(ctx, clients[n], want); err != nil {
+				lastErr = fmt.Errorf("node %s: %w", n, err)
+				continue
+			}
+
+			d := time.Since(created)
+			delete(pending, n)
+			fmt.Printf("node %s: batch %s known after %s\n", n, want.BatchID, d)
+
+			if o.MetricsEnabled {
+				propagationGauge.WithLabelValues(n, want.BatchID).Set(d.Seconds())
+				propagationHistogram.Observe(d.Seconds())
+				if err := o.MetricsPusher.Push(); err != nil {
+					fmt.Printf("node %s: %v\n", n, err)
+				}
+			}
+		}
+
+		if len(pending) == 0 {
+			return nil
+		}
+
+		select {
+		case <-time.After(o.RetryDelay):
+		case <-ctx.Done():
+			return fmt.Errorf("%w to %d nodes within %s: %v", errBatchNotPropagated, len(pending), o.Timeout, lastErr)
+		}
+	}
+}
+
+// checkBatch checks that node's batch store holds the batch with the same
+// depth, amount and bucket depth
+func checkBatch(ctx context.Context, client *bee.Client, want debugapi.Batch) error {
+	b, err := globalBatch(ctx, client, want.BatchID)
+	if err != nil {
+		return err
+	}
+
+	return compareBatch(b, want)
+}
+
+// globalBatch returns batch with a given ID from node's batch store
+func globalBatch(ctx context.Context, client *bee.Client, batchID string) (debugapi.Batch, error) {
+	batches, err := client.GlobalPostageBatches(ctx)
+	if err != nil {
+		return debugapi.Batch{}, fmt.Errorf("get batches: %w", err)
+	}
+
+	for _, b := range batches {
+		if b.BatchID == batchID {
+			return b, nil
+		}
+	}
+
+	return debugapi.Batch{}, fmt.Errorf("batch %s not known", batchID)
+}
+
+// compareBatch compares depth, amount and bucket depth of batches
+func compareBatch(have, want debugapi.Batch) error {
+	if have.Depth != want.Depth {
+		return fmt.Errorf("batch depth: have %d; want %d", have.Depth, want.Depth)
+	}
+	if amount(have) != amount(want) {
+		return fmt.Errorf("batch amount: have %s; want %s", amount(have), amount(want))
+	}
+	if have.BucketDepth != want.BucketDepth {
+		return fmt.Errorf("batch bucket depth: have %d; want %d", have.BucketDepth, want.BucketDepth)
+	}
+
+	return nil
+}
+
+// amount returns printable batch amount
+func amount(b debugapi.Batch) string {
+	if b.Value == nil || b.Value.Int == nil {
+		return "<nil>"
+	}
+	return b.Value.String()
+}
@@ -0,0 +1,24 @@
+package batches
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var (
+	propagationGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "beekeeper",
+			Subsystem: "check_batches",
+			Name:      "propagation_duration_seconds",
+			Help:      "Batch propagation duration Gauge",
+		},
+		[]string{"node", "batch"},
+	)
+	propagationHistogram = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: "beekeeper",
+			Subsystem: "check_batches",
+			Name:      "propagation_seconds",
+			Help:      "Batch propagation duration Histogram",
+			Buckets:   prometheus.ExponentialBuckets(1, 2, 10),
+		},
+	)
+)
@@ -0,0 +1,162 @@
+package chequebook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethersphere/beekeeper/pkg/bee"
+	"github.com/ethersphere/beekeeper/pkg/random"
+)
+
+// Options represents chequebook check options
+type Options struct {
+	DepositAmount  int64
+	WithdrawAmount int64
+	Seed           int64
+	Timeout        time.Duration
+	RetryDelay     time.Duration
+}
+
+var (
+	errChequebookBalance = errors.New("chequebook balance")
+	errChequeSettlement  = errors.New("cheque does not match settlement")
+)
+
+// Check deposits to and withdraws from a random node's chequebook and checks
+// chequebook balance changes, then checks that last cheques of all nodes
+// match their settlements with peers
+func Check(c *bee.Cluster, o Options) (err error) {
+	ctx := context.Background()
+	rnd := random.PseudoGenerator(o.Seed)
+	fmt.Println("chequebook: deposit and withdraw check")
+	fmt.Printf("Seed: %d\n", o.Seed)
+
+	node, err := c.RandomNode(ctx, rnd)
+	if err != nil {
+		return fmt.Errorf("random node: %w", err)
+	}
+	client := node.Client()
+
+	// STEP 1: deposit
+	balance, err := client.ChequebookBalance(ctx)
+	if err != nil {
+		return fmt.Errorf("node %s: %w", node.Name(), err)
+	}
+	fmt.Printf("node %s: chequebook balance: total %s, available %s\n", node.Name(), balance.TotalBalance, balance.AvailableBalance)
+
+	tx, err := client.ChequebookDeposit(ctx, o.DepositAmount)
+	if err != nil {
+		return fmt.Errorf("node %s: %w", node.Name(), err)
+	}
+	fmt.Printf("node %s: deposited %d, transaction %s\n", node.Name(), o.DepositAmount, tx)
+
+	if balance, err = waitBalanceChange(ctx, client, balance, big.NewInt(o.DepositAmount), o); err != nil {
+		return fmt.Errorf("node %s: deposit: %w", node.Name(), err)
+	}
+	fmt.Printf("node %s: chequebook balance: total %s, available %s\n", node.Name(), balance.TotalBalance, balance.AvailableBalance)
+
+	// STEP 2: withdraw
+	tx, err = client.ChequebookWithdraw(ctx, o.WithdrawAmount)
+	if err != nil {
+		return fmt.Errorf("node %s: %w", node.Name(), err)
+	}
+	fmt.Printf("node %s: withdrew %d, transaction %s\n", node.Name(), o.WithdrawAmount, tx)
+
+	if balance, err = waitBalanceChange(ctx, client, balance, big.NewInt(-o.WithdrawAmount), o); err != nil {
+		return fmt.Errorf("node %s: withdraw: %w", node.Name(), err)
+	}
+	fmt.Printf("node %s: chequebook balance: total %s, available %s\n", node.Name(), balance.TotalBalance, balance.AvailableBalance)
+
+	// STEP 3: cross-check cheques with settlements
+	clients, err := c.NodesClients(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, n := range c.NodeNames() {
+		if err := checkCheques(ctx, clients[n]); err != nil {
+			return fmt.Errorf("node %s: %w", n, err)
+		}
+		fmt.Printf("node %s: cheques match settlements\n", n)
+	}
+
+	fmt.Println("chequebook check completed successfully")
+	return
+}
+
+// waitBalanceChange waits until chequebook balance changes and checks that
+// total and available balances changed exactly by delta
+func waitBalanceChange(ctx context.Context, client *bee.Client, before bee.ChequebookBalanceResponse, delta *big.Int, o Options) (after bee.ChequebookBalanceResponse, err error) {
+	ctx, cancel := context.WithTimeout(ctx, o.Timeout)
+	defer cancel()
+
+	for {
+		select {
+		case <-time.After(o.RetryDelay):
+		case <-ctx.Done():
+			return bee.ChequebookBalanceResponse{}, fmt.Errorf("%w: not changed within %s", errChequebookBalance, o.Timeout)
+		}
+
+		after, err = client.ChequebookBalance(ctx)
+		if err != nil {
+			fmt.Println(err)
+			continue
+		}
+
+		if after.TotalBalance.Cmp(before.TotalBalance) != 0 || after.AvailableBalance.Cmp(before.AvailableBalance) != 0 {
+			break
+		}
+	}
+
+	if d := new(big.Int).Sub(after.TotalBalance, before.TotalBalance); d.Cmp(delta) != 0 {
+		return bee.ChequebookBalanceResponse{}, fmt.Errorf("%w: total balance changed by %s, want %s", errChequebookBalance, d, delta)
+	}
+	if d := new(big.Int).Sub(after.AvailableBalance, before.AvailableBalance); d.Cmp(delta) != 0 {
+		return bee.ChequebookBalanceResponse{}, fmt.Errorf("%w: available balance changed by %s, want %s", errChequebookBalance, d, delta)
+	}
+
+	return
+}
+
+// checkCheques checks that payouts of node's last cheques match the amounts
+// sent to and received from each peer in settlements
+func checkCheques(ctx context.Context, client *bee.Client) error {
+	cheques, err := client.ChequebookCheques(ctx)
+	if err != nil {
+		return err
+	}
+
+	settlements, err := client.Settlements(ctx)
+	if err != nil {
+		return err
+	}
+
+	peerCheques := make(map[string]bee.PeerCheques)
+	for _, pc := range cheques {
+		peerCheques[pc.Peer] = pc
+	}
+
+	for _, s := range settlements.Settlements {
+		pc := peerCheques[s.Peer]
+
+		if sent := payout(pc.LastSent); sent.Cmp(big.NewInt(int64(s.Sent))) != 0 {
+			return fmt.Errorf("%w: peer %s: last sent cheque payout %s, settlement sent %d", errChequeSettlement, s.Peer, sent, s.Sent)
+		}
+		if received := payout(pc.LastReceived); received.Cmp(big.NewInt(int64(s.Received))) != 0 {
+			return fmt.Errorf("%w: peer %s: last received cheque payout %s, settlement received %d", errChequeSettlement, s.Peer, received, s.Received)
+		}
+	}
+
+	return nil
+}
+
+// payout returns cumulative payout of the cheque or zero if there is no cheque
+func payout(c *bee.Cheque) *big.Int {
+	if c == nil || c.Payout == nil {
+		return big.NewInt(0)
+	}
+	return c.Payout
+}
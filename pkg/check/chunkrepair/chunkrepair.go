@@ -59,14 +59,18 @@ func Check(c *bee.Cluster, o Options, pusher *push.Pusher, pushMetrics bool) (er
 			return err
 		}
 
-		batchID, err := nodeA.CreatePostageBatch(ctx, o.PostageAmount, bee.MinimumBatchDepth, "test-label")
+		batchID, err := nodeA.Batches().Get(ctx, bee.BatchOptions{
+			Amount: o.PostageAmount,
+			Depth:  bee.MinimumBatchDepth,
+			Label:  "test-label",
+			Chunks: 1,
+			Wait:   o.PostageWait,
+		})
 		if err != nil {
-			return fmt.Errorf("created batched id %w", err)
+			return fmt.Errorf("batch id %w", err)
 		}
 
-		fmt.Printf("created batched id %s", batchID)
-
-		time.Sleep(o.PostageWait)
+		fmt.Printf("batch id %s\n", batchID)
 
 		// upload the chunk in nodeA
 		ref, err := nodeA.UploadChunk(ctx, chunk.Data(), api.UploadOptions{BatchID: batchID})
@@ -0,0 +1,126 @@
+package durability
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethersphere/beekeeper/pkg/bee"
+	"github.com/ethersphere/beekeeper/pkg/ledger"
+	"github.com/ethersphere/beekeeper/pkg/random"
+)
+
+// Options represents durability check options
+type Options struct {
+	Ledger         *ledger.Ledger
+	Seed           int64
+	Samples        int // number of verified entries, all entries if not set
+	MinAge         time.Duration
+	AgeBuckets     []time.Duration
+	MinRetrievable float64 // percentage of entries that must be retrievable
+	Retries        int
+	RetryDelay     time.Duration
+}
+
+var errDurability = errors.New("durability")
+
+// Check downloads content recorded in the ledger, possibly by previous
+// beekeeper runs, from random nodes other than the uploader and checks that
+// the percentage of retrievable content is not below the minimum. It reports
+// retrievability of content by its age.
+func Check(c *bee.Cluster, o Options) (err error) {
+	ctx := context.Background()
+	rnd := random.PseudoGenerator(o.Seed)
+	fmt.Println("durability: ledger entries retrievability check")
+	fmt.Printf("Seed: %d\n", o.Seed)
+
+	if o.Ledger == nil {
+		return fmt.Errorf("%w: ledger not set", errDurability)
+	}
+
+	clients, err := c.NodesClients(ctx)
+	if err != nil {
+		return err
+	}
+	sortedNodes := c.NodeNames()
+
+	var entries []ledger.Entry
+	for _, e := range o.Ledger.Entries() {
+		if time.Since(e.Uploaded) >= o.MinAge {
+			entries = append(entries, e)
+		}
+	}
+	if o.Samples > 0 && o.Samples < len(entries) {
+		perm := rnd.Perm(len(entries))[:o.Samples]
+		sample := make([]ledger.Entry, len(perm))
+		for i, j := range perm {
+			sample[i] = entries[j]
+		}
+		entries = sample
+	}
+	if len(entries) == 0 {
+		fmt.Printf("no ledger entries older than %s\n", o.MinAge)
+		return nil
+	}
+	fmt.Printf("verifying %d of %d ledger entries\n", len(entries), o.Ledger.Len())
+
+	durability := ledger.NewDurability(o.AgeBuckets)
+	retrievable := 0
+	for _, e := range entries {
+		// uploader may no longer be in the cluster
+		candidates := make([]string, 0, len(sortedNodes))
+		for _, n := range sortedNodes {
+			if n != e.Node {
+				candidates = append(candidates, n)
+			}
+		}
+		if len(candidates) == 0 {
+			candidates = sortedNodes
+		}
+		node := candidates[rnd.Intn(len(candidates))]
+
+		err := verify(ctx, clients[node], e, o)
+		age := time.Since(e.Uploaded)
+		durability.Record(age, err == nil)
+		if err != nil {
+			fmt.Printf("node %s: %s %s uploaded to node %s by %q %s ago not retrievable: %v\n", node, e.Kind, e.Reference, e.Node, e.Source, age.Round(time.Second), err)
+			continue
+		}
+		retrievable++
+	}
+
+	durability.Report()
+
+	percentage := 100 * float64(retrievable) / float64(len(entries))
+	fmt.Printf("%d/%d ledger entries retrievable (%.2f%%)\n", retrievable, len(entries), percentage)
+	if percentage < o.MinRetrievable {
+		return fmt.Errorf("%w: %.2f%% of ledger entries retrievable, want at least %.2f%%", errDurability, percentage, o.MinRetrievable)
+	}
+
+	fmt.Println("durability check completed successfully")
+	return
+}
+
+// verify downloads ledger entry and compares its hash, retrying on errors
+func verify(ctx context.Context, client *bee.Client, e ledger.Entry, o Options) (err error) {
+	for i := 0; i <= o.Retries; i++ {
+		if i > 0 {
+			time.Sleep(o.RetryDelay)
+		}
+
+		var size int64
+		var hash []byte
+		size, hash, err = client.DownloadLedgerEntry(ctx, e)
+		if err != nil {
+			continue
+		}
+		if !bytes.Equal(hash, e.Hash) {
+			return fmt.Errorf("hash mismatch, downloaded %d bytes, uploaded %d bytes", size, e.Size)
+		}
+		return nil
+	}
+
+	return err
+}
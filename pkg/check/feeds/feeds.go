@@ -0,0 +1,156 @@
+package feeds
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethersphere/bee/pkg/crypto"
+	"github.com/ethersphere/beekeeper/pkg/bee"
+	"github.com/ethersphere/beekeeper/pkg/beeclient/api"
+	"github.com/ethersphere/beekeeper/pkg/random"
+)
+
+// Options represents feeds check options
+type Options struct {
+	UpdateCount    int
+	UpdateSize     int
+	Seed           int64
+	PostageAmount  int64
+	PostageWait    time.Duration
+	PostageDepth   uint64
+	RequestTimeout time.Duration
+	RetryDelay     time.Duration
+}
+
+var errFeedLookup = errors.New("feed lookup")
+
+// Check publishes sequential feed updates from one node and checks that all
+// other nodes in the cluster resolve the latest update
+func Check(c *bee.Cluster, o Options) (err error) {
+	ctx := context.Background()
+	rnd := random.PseudoGenerator(o.Seed)
+	fmt.Printf("Seed: %d\n", o.Seed)
+
+	if o.UpdateCount < 1 {
+		return errors.New("update count must be at least 1")
+	}
+
+	privKey, err := crypto.GenerateSecp256k1Key()
+	if err != nil {
+		return err
+	}
+
+	topic := make([]byte, 32)
+	if _, err := rnd.Read(topic); err != nil {
+		return fmt.Errorf("create topic: %w", err)
+	}
+
+	feed, err := bee.NewFeed(crypto.NewDefaultSigner(privKey), topic)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("feed: owner %s, topic %s\n", feed.Owner(), feed.Topic())
+
+	clients, err := c.NodesClients(ctx)
+	if err != nil {
+		return err
+	}
+
+	sortedNodes := c.NodeNames()
+	uploader := sortedNodes[rnd.Intn(len(sortedNodes))]
+	client := clients[uploader]
+
+	batchID, err := client.Batches().Get(ctx, bee.BatchOptions{
+		Amount: o.PostageAmount,
+		Depth:  o.PostageDepth,
+		Label:  "test-label",
+		Chunks: int64(o.UpdateCount)*(bee.CalculateNumberOfChunks(int64(o.UpdateSize), false)+1) + 1,
+		Wait:   o.PostageWait,
+	})
+	if err != nil {
+		return fmt.Errorf("node %s: batch id %w", uploader, err)
+	}
+	fmt.Printf("node %s: batch id %s\n", uploader, batchID)
+
+	manifest, err := client.CreateFeedManifest(ctx, feed.Owner(), feed.Topic(), batchID)
+	if err != nil {
+		return fmt.Errorf("node %s: %w", uploader, err)
+	}
+	fmt.Printf("node %s: feed manifest %s created\n", uploader, manifest)
+
+	var data []byte
+	for i := 0; i < o.UpdateCount; i++ {
+		data = make([]byte, o.UpdateSize)
+		if _, err := rnd.Read(data); err != nil {
+			return fmt.Errorf("create random data: %w", err)
+		}
+
+		ref, err := client.UploadBytes(ctx, data, api.UploadOptions{BatchID: batchID})
+		if err != nil {
+			return fmt.Errorf("node %s: %w", uploader, err)
+		}
+
+		update, err := feed.Next(time.Now().Unix(), ref.Bytes())
+		if err != nil {
+			return err
+		}
+
+		if _, err := client.UploadFeedUpdate(ctx, feed.Owner(), update, batchID); err != nil {
+			return fmt.Errorf("node %s: %w", uploader, err)
+		}
+		fmt.Printf("node %s: feed update %d with reference %s uploaded\n", uploader, update.Index, ref)
+	}
+
+	lastIndex := uint64(o.UpdateCount - 1)
+	for _, n := range sortedNodes {
+		if n == uploader {
+			continue
+		}
+
+		if err := checkLatestUpdate(ctx, clients[n], feed, lastIndex, data, o); err != nil {
+			return fmt.Errorf("node %s: %w", n, err)
+		}
+		fmt.Printf("node %s: feed update %d resolved successfully\n", n, lastIndex)
+	}
+
+	fmt.Println("feeds check completed successfully")
+	return
+}
+
+// checkLatestUpdate waits until the node resolves feed's update with a given
+// index and checks that its payload matches data
+func checkLatestUpdate(ctx context.Context, client *bee.Client, feed *bee.Feed, index uint64, data []byte, o Options) (err error) {
+	ctx, cancel := context.WithTimeout(ctx, o.RequestTimeout)
+	defer cancel()
+
+	var resp api.FeedUpdateResponse
+	for {
+		resp, err = client.FindFeedUpdate(ctx, feed.Owner(), feed.Topic())
+		if err == nil && resp.Index == index {
+			break
+		}
+
+		select {
+		case <-time.After(o.RetryDelay):
+		case <-ctx.Done():
+			if err != nil {
+				return fmt.Errorf("%w: %v", errFeedLookup, err)
+			}
+			return fmt.Errorf("%w: got index %d, want %d", errFeedLookup, resp.Index, index)
+		}
+	}
+
+	retrieved, err := client.DownloadBytes(ctx, resp.Reference)
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(retrieved, data) {
+		return fmt.Errorf("%w: feed update %d payload mismatch", errFeedLookup, index)
+	}
+
+	return
+}
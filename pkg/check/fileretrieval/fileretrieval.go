@@ -23,9 +23,12 @@ type Options struct {
 	Seed            int64
 	PostageAmount   int64
 	PostageWait     time.Duration
+	Encrypt         bool
 }
 
-var errFileRetrieval = errors.New("file retrieval")
+var (
+	errFileRetrieval = errors.New("file retrieval")
+)
 
 // Check uploads files on cluster and downloads them from the last node in the cluster
 func Check(c *bee.Cluster, o Options, pusher *push.Pusher, pushMetrics bool) (err error) {
@@ -59,24 +62,30 @@ func Check(c *bee.Cluster, o Options, pusher *push.Pusher, pushMetrics bool) (er
 	for i := 0; i < o.UploadNodeCount; i++ {
 		nodeName := sortedNodes[i]
 		for j := 0; j < o.FilesPerNode; j++ {
-			file := bee.NewRandomFile(rnds[i], fmt.Sprintf("%s-%d-%d", o.FileName, i, j), o.FileSize)
-
-			depth := 2 + bee.EstimatePostageBatchDepth(file.Size())
-			batchID, err := clients[nodeName].CreatePostageBatch(ctx, o.PostageAmount, depth, "test-label")
+			file := bee.NewRandomFileStream(rnds[i].Int63(), fmt.Sprintf("%s-%d-%d", o.FileName, i, j), o.FileSize)
+
+			batchID, err := clients[nodeName].Batches().Get(ctx, bee.BatchOptions{
+				Amount: o.PostageAmount,
+				Depth:  2 + bee.EstimatePostageBatchDepth(file.Size()),
+				Label:  "test-label",
+				Chunks: bee.CalculateNumberOfChunks(file.Size(), o.Encrypt),
+				Wait:   o.PostageWait,
+			})
 			if err != nil {
-				return fmt.Errorf("node %s: created batched id %w", nodeName, err)
+				return fmt.Errorf("node %s: batch id %w", nodeName, err)
 			}
 
-			fmt.Printf("node %s: created batched id %s\n", nodeName, batchID)
-
-			time.Sleep(o.PostageWait)
+			fmt.Printf("node %s: batch id %s\n", nodeName, batchID)
 
 			t0 := time.Now()
 
 			client := clients[nodeName]
-			if err := client.UploadFile(ctx, &file, api.UploadOptions{BatchID: batchID}); err != nil {
+			if err := client.UploadFile(ctx, &file, api.UploadOptions{BatchID: batchID, Encrypt: o.Encrypt}); err != nil {
 				return fmt.Errorf("node %s: %w", nodeName, err)
 			}
+			if bee.IsEncryptedReference(file.Address()) != o.Encrypt {
+				return fmt.Errorf("node %s: file %s: %w", nodeName, file.Address().String(), bee.ErrReferenceType)
+			}
 
 			d0 := time.Since(t0)
 
@@ -151,22 +160,28 @@ func CheckFull(c *bee.Cluster, o Options, pusher *push.Pusher, pushMetrics bool)
 	for i := 0; i < o.UploadNodeCount; i++ {
 		nodeName := sortedNodes[i]
 		for j := 0; j < o.FilesPerNode; j++ {
-			file := bee.NewRandomFile(rnds[i], fmt.Sprintf("%s-%d-%d", o.FileName, i, j), o.FileSize)
-
-			depth := 2 + bee.EstimatePostageBatchDepth(file.Size())
-			batchID, err := clients[nodeName].CreatePostageBatch(ctx, o.PostageAmount, depth, "test-label")
+			file := bee.NewRandomFileStream(rnds[i].Int63(), fmt.Sprintf("%s-%d-%d", o.FileName, i, j), o.FileSize)
+
+			batchID, err := clients[nodeName].Batches().Get(ctx, bee.BatchOptions{
+				Amount: o.PostageAmount,
+				Depth:  2 + bee.EstimatePostageBatchDepth(file.Size()),
+				Label:  "test-label",
+				Chunks: bee.CalculateNumberOfChunks(file.Size(), o.Encrypt),
+				Wait:   o.PostageWait,
+			})
 			if err != nil {
-				return fmt.Errorf("node %s: created batched id %w", nodeName, err)
+				return fmt.Errorf("node %s: batch id %w", nodeName, err)
 			}
 
-			fmt.Printf("node %s: created batched id %s\n", nodeName, batchID)
-
-			time.Sleep(o.PostageWait)
+			fmt.Printf("node %s: batch id %s\n", nodeName, batchID)
 
 			t0 := time.Now()
-			if err := clients[nodeName].UploadFile(ctx, &file, api.UploadOptions{BatchID: batchID}); err != nil {
+			if err := clients[nodeName].UploadFile(ctx, &file, api.UploadOptions{BatchID: batchID, Encrypt: o.Encrypt}); err != nil {
 				return fmt.Errorf("node %s: %w", nodeName, err)
 			}
+			if bee.IsEncryptedReference(file.Address()) != o.Encrypt {
+				return fmt.Errorf("node %s: file %s: %w", nodeName, file.Address().String(), bee.ErrReferenceType)
+			}
 			d0 := time.Since(t0)
 
 			uploadedCounter.WithLabelValues(overlays[nodeName].String()).Inc()
@@ -14,6 +14,10 @@ import (
 	"github.com/ethersphere/beekeeper/pkg/random"
 )
 
+// garbageCollectionWait is the time given to the node to garbage collect
+// chunks after the reserve is changed by a new batch
+const garbageCollectionWait = 5 * time.Second
+
 // Options represents gc check options
 type Options struct {
 	CacheSize     int // size of the node's localstore in chunks
@@ -24,7 +28,9 @@ type Options struct {
 }
 
 func CheckReserve(c *bee.Cluster, o Options) error {
-	ctx := context.Background()
+	// chunks are expected to be garbage collected, so they are not recorded
+	// in the ledger
+	ctx := bee.WithoutLedger(context.Background())
 	rnd := random.PseudoGenerator(o.Seed)
 	fmt.Println("gc: reserve check")
 	fmt.Printf("Seed: %d\n", o.Seed)
@@ -61,7 +67,9 @@ func CheckReserve(c *bee.Cluster, o Options) error {
 		return fmt.Errorf("create batch: %w", err)
 	}
 	fmt.Printf("created batch id %s with depth %d and amount %d\n", batchID, depth, hiAmount)
-	time.Sleep(o.PostageWait)
+	if err := client.WaitPostageBatch(ctx, batchID, o.PostageWait); err != nil {
+		return err
+	}
 
 	state, err := node.Client().ReserveState(ctx)
 	if err != nil {
@@ -69,14 +77,21 @@ func CheckReserve(c *bee.Cluster, o Options) error {
 	}
 	fmt.Println("reservestate:", state)
 
-	pinnedChunk := bee.GenerateRandomChunkAt(rnd, overlay, 0)
+	pinnedChunks, err := chunkBatch(rnd, overlay, 1, 0)
+	if err != nil {
+		return err
+	}
+	pinnedChunk := pinnedChunks[0]
 	_, err = client.UploadChunk(ctx, pinnedChunk.Data(), api.UploadOptions{Pin: true, BatchID: batchID})
 	if err != nil {
 		return fmt.Errorf("unable to upload chunk: %w", err)
 	}
 	fmt.Printf("uploaded pinned chunk %q\n", pinnedChunk.Address())
 
-	lowValueChunks := chunkBatch(rnd, overlay, o.CacheSize, origState.Radius)
+	lowValueChunks, err := chunkBatch(rnd, overlay, o.CacheSize, origState.Radius)
+	if err != nil {
+		return err
+	}
 	for _, c := range lowValueChunks {
 		_, err := client.UploadChunk(ctx, c.Data(), api.UploadOptions{BatchID: batchID})
 		if err != nil {
@@ -88,7 +103,10 @@ func CheckReserve(c *bee.Cluster, o Options) error {
 	// upload higher radius chunks that should not be garbage collected
 	higherRadius := origState.Radius + 1
 	higherRadiusChunkCount := int(float64(o.CacheSize) * 0.1)
-	lowValueHigherRadiusChunks := chunkBatch(rnd, overlay, higherRadiusChunkCount, higherRadius)
+	lowValueHigherRadiusChunks, err := chunkBatch(rnd, overlay, higherRadiusChunkCount, higherRadius)
+	if err != nil {
+		return err
+	}
 	for _, c := range lowValueHigherRadiusChunks {
 		if _, err := client.UploadChunk(ctx, c.Data(), api.UploadOptions{BatchID: batchID}); err != nil {
 			return fmt.Errorf("low value chunk: %w", err)
@@ -102,7 +120,10 @@ func CheckReserve(c *bee.Cluster, o Options) error {
 		return fmt.Errorf("create batch: %w", err)
 	}
 	fmt.Printf("created batch id %s with depth %d and amount %d\n", highValueBatch, depth, hiAmount)
-	time.Sleep(o.PostageWait)
+	if err := client.WaitPostageBatch(ctx, highValueBatch, o.PostageWait); err != nil {
+		return err
+	}
+	time.Sleep(garbageCollectionWait)
 
 	state, err = node.Client().ReserveState(ctx)
 	if err != nil {
@@ -154,7 +175,10 @@ func CheckReserve(c *bee.Cluster, o Options) error {
 	}
 
 	// STEP 3: Upload chunks with high value batch, then create a low value batch, and confirm no chunks were garbage collected
-	highValueChunks := chunkBatch(rnd, overlay, o.CacheSize, state.Radius)
+	highValueChunks, err := chunkBatch(rnd, overlay, o.CacheSize, state.Radius)
+	if err != nil {
+		return err
+	}
 	for _, c := range highValueChunks {
 		if _, err := client.UploadChunk(ctx, c.Data(), api.UploadOptions{BatchID: highValueBatch}); err != nil {
 			return fmt.Errorf("high value chunks: %w", err)
@@ -167,7 +191,10 @@ func CheckReserve(c *bee.Cluster, o Options) error {
 		return fmt.Errorf("create batch: %w", err)
 	}
 	fmt.Printf("created batch id %s with depth %d and amount %d\n", batchID, depth, hiAmount)
-	time.Sleep(o.PostageWait)
+	if err := client.WaitPostageBatch(ctx, batchID, o.PostageWait); err != nil {
+		return err
+	}
+	time.Sleep(garbageCollectionWait)
 
 	state, err = client.ReserveState(ctx)
 	if err != nil {
@@ -241,10 +268,18 @@ func capacityToDepth(radius uint8, available int64) uint64 {
 	return uint64(depth) + 1
 }
 
-func chunkBatch(rnd *rand.Rand, target swarm.Address, count int, po uint8) []swarm.Chunk {
-	chunks := make([]swarm.Chunk, count)
-	for i := range chunks {
-		chunks[i] = bee.GenerateRandomChunkAt(rnd, target, po)
+// chunkBatch returns count chunks in the bin po of the target
+func chunkBatch(rnd *rand.Rand, target swarm.Address, count int, po uint8) ([]swarm.Chunk, error) {
+	g, err := bee.NewDatasetGenerator(bee.DatasetOptions{
+		Seed:         rnd.Int63(),
+		Distribution: bee.DistributionProximity,
+		Targets:      []swarm.Address{target},
+		Depth:        po,
+		PayloadSize:  swarm.ChunkSize,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("dataset generator: %w", err)
 	}
-	return chunks
+
+	return g.Chunks(count)
 }
@@ -1,13 +1,11 @@
 package manifest
 
 import (
-	"archive/tar"
 	"bytes"
 	"context"
 	"encoding/hex"
 	"errors"
 	"fmt"
-	"io/ioutil"
 	"math/rand"
 	"time"
 
@@ -24,6 +22,7 @@ type Options struct {
 	PostageAmount     int64
 	PostageWait       time.Duration
 	PostageDepth      uint64
+	Encrypt           bool
 }
 
 var errManifest = errors.New("manifest data mismatch")
@@ -45,12 +44,11 @@ func Check(c *bee.Cluster, o Options) error {
 		return err
 	}
 
-	tarReader, err := tarFiles(files)
+	tarFile, err := bee.NewCollectionFile("", files)
 	if err != nil {
 		return err
 	}
 
-	tarFile := bee.NewBufferFile("", tarReader)
 	clients, err := c.NodesClients(ctx)
 	if err != nil {
 		return err
@@ -61,15 +59,24 @@ func Check(c *bee.Cluster, o Options) error {
 
 	client := clients[node]
 
-	batchID, err := client.GetOrCreateBatch(ctx, o.PostageDepth, o.PostageWait)
+	batchID, err := client.Batches().Get(ctx, bee.BatchOptions{
+		Amount: o.PostageAmount,
+		Depth:  o.PostageDepth,
+		Label:  "test-label",
+		Chunks: bee.CalculateNumberOfChunks(tarFile.Size(), o.Encrypt) + int64(o.FilesInCollection),
+		Wait:   o.PostageWait,
+	})
 	if err != nil {
 		return fmt.Errorf("node %s: batch id %w", node, err)
 	}
 	fmt.Printf("node %s: batch id %s\n", node, batchID)
 
-	if err := client.UploadCollection(ctx, &tarFile, api.UploadOptions{BatchID: batchID}); err != nil {
+	if err := client.UploadCollection(ctx, &tarFile, api.UploadOptions{BatchID: batchID, Encrypt: o.Encrypt}); err != nil {
 		return fmt.Errorf("node %d: %w", 0, err)
 	}
+	if bee.IsEncryptedReference(tarFile.Address()) != o.Encrypt {
+		return fmt.Errorf("node %s: collection %s: %w", node, tarFile.Address().String(), bee.ErrReferenceType)
+	}
 
 	lastNode := sortedNodes[len(sortedNodes)-1]
 	try := 0
@@ -129,38 +136,3 @@ func generateFiles(r *rand.Rand, filesCount int, maxPathnameLength int32) ([]bee
 
 	return files, nil
 }
-
-// tarFiles receives an array of files and creates a new tar archive with those
-// files as a collection.
-func tarFiles(files []bee.File) (*bytes.Buffer, error) {
-	var buf bytes.Buffer
-	tw := tar.NewWriter(&buf)
-
-	for _, file := range files {
-		// create tar header and write it
-		hdr := &tar.Header{
-			Name: file.Name(),
-			Mode: 0600,
-			Size: file.Size(),
-		}
-		if err := tw.WriteHeader(hdr); err != nil {
-			return nil, err
-		}
-
-		b, err := ioutil.ReadAll(file.DataReader())
-		if err != nil {
-			return nil, err
-		}
-
-		// write the file data to the tar
-		if _, err := tw.Write(b); err != nil {
-			return nil, err
-		}
-	}
-
-	if err := tw.Close(); err != nil {
-		return nil, err
-	}
-
-	return &buf, nil
-}
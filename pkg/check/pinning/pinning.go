@@ -0,0 +1,280 @@
+package pinning
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/ethersphere/bee/pkg/swarm"
+	"github.com/ethersphere/beekeeper/pkg/bee"
+	"github.com/ethersphere/beekeeper/pkg/beeclient/api"
+	"github.com/ethersphere/beekeeper/pkg/random"
+)
+
+// Options represents pinning check options
+type Options struct {
+	CacheSize         int // size of the node's localstore cache in chunks
+	ChunkCount        int
+	FileSize          int64
+	FilesInCollection int
+	Seed              int64
+	PostageAmount     int64
+	PostageWait       time.Duration
+	GCWait            time.Duration
+	GCRetries         int
+}
+
+var errPinning = errors.New("pinning")
+
+// Check uploads pinned and unpinned content to a random node, floods node's
+// cache to force garbage collection and checks that pinned content survives
+// while unpinned content becomes collectable
+func Check(c *bee.Cluster, o Options) (err error) {
+	ctx := context.Background()
+	rnd := random.PseudoGenerator(o.Seed)
+	fmt.Println("pinning: lifecycle check")
+	fmt.Printf("Seed: %d\n", o.Seed)
+
+	node, err := c.RandomNode(ctx, rnd)
+	if err != nil {
+		return fmt.Errorf("random node: %w", err)
+	}
+	client := node.Client()
+	fmt.Printf("node %s\n", node.Name())
+
+	overlay, err := client.Overlay(ctx)
+	if err != nil {
+		return fmt.Errorf("node %s: %w", node.Name(), err)
+	}
+
+	// most chunks are generated in bin 0 of the node, sharing the first
+	// address bit
+	chunks := int64(2*o.CacheSize+2*o.ChunkCount) + 3*bee.CalculateNumberOfChunks(o.FileSize, false)
+	batchID, err := client.Batches().Get(ctx, bee.BatchOptions{
+		Amount: o.PostageAmount,
+		Depth:  2 + bee.EstimatePostageBatchDepth(chunks*swarm.ChunkSize),
+		Label:  "test-label",
+		Chunks: chunks,
+		Prefix: 1,
+		Wait:   o.PostageWait,
+	})
+	if err != nil {
+		return fmt.Errorf("node %s: batch id %w", node.Name(), err)
+	}
+	fmt.Printf("node %s: batch id %s\n", node.Name(), batchID)
+
+	// STEP 1: pin content on upload and after the fact
+	pinnedFile := bee.NewRandomFileStream(rnd.Int63(), "pinned", o.FileSize)
+	if err := client.UploadFile(ctx, &pinnedFile, api.UploadOptions{Pin: true, BatchID: batchID}); err != nil {
+		return fmt.Errorf("node %s: pinned file: %w", node.Name(), err)
+	}
+	fmt.Printf("uploaded file %s pinned on upload\n", pinnedFile.Address())
+
+	files := make([]bee.File, o.FilesInCollection)
+	for i := range files {
+		files[i] = bee.NewRandomFileStream(rnd.Int63(), fmt.Sprintf("file-%d", i), o.FileSize/int64(o.FilesInCollection)+1)
+		if err := files[i].CalculateHash(); err != nil {
+			return fmt.Errorf("collection file %s: %w", files[i].Name(), err)
+		}
+	}
+	pinnedCollection, err := bee.NewCollectionFile("pinned-collection", files)
+	if err != nil {
+		return fmt.Errorf("create collection: %w", err)
+	}
+	if err := client.UploadCollection(ctx, &pinnedCollection, api.UploadOptions{Pin: true, BatchID: batchID}); err != nil {
+		return fmt.Errorf("node %s: pinned collection: %w", node.Name(), err)
+	}
+	fmt.Printf("uploaded collection %s pinned on upload\n", pinnedCollection.Address())
+
+	laterPinnedFile := bee.NewRandomFileStream(rnd.Int63(), "later-pinned", o.FileSize)
+	if err := client.UploadFile(ctx, &laterPinnedFile, api.UploadOptions{BatchID: batchID}); err != nil {
+		return fmt.Errorf("node %s: later pinned file: %w", node.Name(), err)
+	}
+	if err := client.PinRootHash(ctx, laterPinnedFile.Address()); err != nil {
+		return fmt.Errorf("node %s: pin file %s: %w", node.Name(), laterPinnedFile.Address(), err)
+	}
+	fmt.Printf("uploaded file %s pinned after upload\n", laterPinnedFile.Address())
+
+	// chunks far from the node are stored only in its cache, so they are
+	// garbage collected unless they are pinned
+	pinnedChunks, err := chunkBatch(rnd, overlay, o.ChunkCount)
+	if err != nil {
+		return fmt.Errorf("node %s: pinned chunks: %w", node.Name(), err)
+	}
+	for _, ch := range pinnedChunks {
+		if _, err := client.UploadChunk(ctx, ch.Data(), api.UploadOptions{Pin: true, BatchID: batchID}); err != nil {
+			return fmt.Errorf("node %s: pinned chunk: %w", node.Name(), err)
+		}
+	}
+	fmt.Printf("uploaded %d pinned chunks\n", len(pinnedChunks))
+
+	// unpinned and flood chunks are expected to be garbage collected, so
+	// they are not recorded in the ledger
+	noLedger := bee.WithoutLedger(ctx)
+
+	unpinnedChunks, err := chunkBatch(rnd, overlay, o.ChunkCount)
+	if err != nil {
+		return fmt.Errorf("node %s: unpinned chunks: %w", node.Name(), err)
+	}
+	for _, ch := range unpinnedChunks {
+		if _, err := client.UploadChunk(noLedger, ch.Data(), api.UploadOptions{Pin: true, BatchID: batchID}); err != nil {
+			return fmt.Errorf("node %s: unpinned chunk: %w", node.Name(), err)
+		}
+	}
+	fmt.Printf("uploaded %d chunks to be unpinned\n", len(unpinnedChunks))
+
+	// STEP 2: list and verify pins
+	pinned := []swarm.Address{pinnedFile.Address(), pinnedCollection.Address(), laterPinnedFile.Address()}
+	for _, ch := range pinnedChunks {
+		pinned = append(pinned, ch.Address())
+	}
+	for _, ch := range unpinnedChunks {
+		pinned = append(pinned, ch.Address())
+	}
+	if err := checkPins(ctx, client, pinned, nil); err != nil {
+		return fmt.Errorf("node %s: %w", node.Name(), err)
+	}
+	fmt.Printf("verified %d pins\n", len(pinned))
+
+	// STEP 3: unpin and verify pins
+	var unpinned []swarm.Address
+	for _, ch := range unpinnedChunks {
+		if err := client.UnpinRootHash(ctx, ch.Address()); err != nil {
+			return fmt.Errorf("node %s: unpin chunk %s: %w", node.Name(), ch.Address(), err)
+		}
+		unpinned = append(unpinned, ch.Address())
+	}
+	pinned = pinned[:len(pinned)-len(unpinnedChunks)]
+	if err := checkPins(ctx, client, pinned, unpinned); err != nil {
+		return fmt.Errorf("node %s: %w", node.Name(), err)
+	}
+	fmt.Printf("verified %d pins after unpinning %d chunks\n", len(pinned), len(unpinned))
+
+	// STEP 4: flood node's cache to force garbage collection
+	floodChunks, err := chunkBatch(rnd, overlay, 2*o.CacheSize)
+	if err != nil {
+		return fmt.Errorf("node %s: flood chunks: %w", node.Name(), err)
+	}
+	for _, ch := range floodChunks {
+		if _, err := client.UploadChunk(noLedger, ch.Data(), api.UploadOptions{BatchID: batchID}); err != nil {
+			return fmt.Errorf("node %s: flood chunk: %w", node.Name(), err)
+		}
+	}
+	fmt.Printf("uploaded %d chunks to flood the cache\n", len(floodChunks))
+
+	// STEP 5: pinned content must survive and unpinned content must be collected
+	for r := 0; r < o.GCRetries; r++ {
+		time.Sleep(o.GCWait)
+
+		var hasCount int
+		for _, ch := range unpinnedChunks {
+			has, err := client.HasChunk(ctx, ch.Address())
+			if err != nil {
+				return fmt.Errorf("node %s: unpinned chunk %s: %w", node.Name(), ch.Address(), err)
+			}
+			if has {
+				hasCount++
+			}
+		}
+		fmt.Printf("retrieved unpinned chunks: %d, gc'd count: %d\n", hasCount, len(unpinnedChunks)-hasCount)
+
+		if hasCount == 0 {
+			break
+		}
+		if r == o.GCRetries-1 {
+			return fmt.Errorf("%w: %d unpinned chunks were not garbage collected", errPinning, hasCount)
+		}
+	}
+
+	for _, ch := range pinnedChunks {
+		has, err := client.HasChunk(ctx, ch.Address())
+		if err != nil {
+			return fmt.Errorf("node %s: pinned chunk %s: %w", node.Name(), ch.Address(), err)
+		}
+		if !has {
+			return fmt.Errorf("%w: pinned chunk %s was garbage collected", errPinning, ch.Address())
+		}
+	}
+	fmt.Printf("all %d pinned chunks survived garbage collection\n", len(pinnedChunks))
+
+	for _, f := range []bee.File{pinnedFile, laterPinnedFile} {
+		size, hash, err := client.DownloadFile(ctx, f.Address())
+		if err != nil {
+			return fmt.Errorf("node %s: pinned file %s: %w", node.Name(), f.Address(), err)
+		}
+		if !bytes.Equal(f.Hash(), hash) {
+			return fmt.Errorf("%w: pinned file %s not retrieved successfully. Uploaded size: %d Downloaded size: %d", errPinning, f.Address(), f.Size(), size)
+		}
+	}
+
+	for _, f := range files {
+		size, hash, err := client.DownloadManifestFile(ctx, pinnedCollection.Address(), f.Name())
+		if err != nil {
+			return fmt.Errorf("node %s: pinned collection file %s: %w", node.Name(), f.Name(), err)
+		}
+		if !bytes.Equal(f.Hash(), hash) {
+			return fmt.Errorf("%w: pinned collection file %s/%s not retrieved successfully. Uploaded size: %d Downloaded size: %d", errPinning, pinnedCollection.Address(), f.Name(), f.Size(), size)
+		}
+	}
+	fmt.Println("all pinned files survived garbage collection")
+
+	fmt.Println("pinning check completed successfully")
+	return
+}
+
+// checkPins checks that node's pins contain all pinned and none of unpinned references
+func checkPins(ctx context.Context, client *bee.Client, pinned, unpinned []swarm.Address) error {
+	pins, err := client.GetPins(ctx)
+	if err != nil {
+		return fmt.Errorf("get pins: %w", err)
+	}
+
+	for _, a := range pinned {
+		if !a.MemberOf(pins) {
+			return fmt.Errorf("%w: %s not found in pins", errPinning, a)
+		}
+
+		have, err := client.GetPinnedRootHash(ctx, a)
+		if err != nil {
+			return fmt.Errorf("get pinned root hash %s: %w", a, err)
+		}
+		if !have.Equal(a) {
+			return fmt.Errorf("%w: address mismatch: have %s; want %s", errPinning, have, a)
+		}
+	}
+
+	for _, a := range unpinned {
+		if a.MemberOf(pins) {
+			return fmt.Errorf("%w: unpinned %s found in pins", errPinning, a)
+		}
+
+		have, err := client.GetPinnedRootHash(ctx, a)
+		if err != nil {
+			return fmt.Errorf("get pinned root hash %s: %w", a, err)
+		}
+		if !have.Equal(swarm.ZeroAddress) {
+			return fmt.Errorf("%w: address mismatch: have %s; want none", errPinning, have)
+		}
+	}
+
+	return nil
+}
+
+// chunkBatch generates chunks outside of the node's neighbourhood, in its
+// bin 0
+func chunkBatch(rnd *rand.Rand, target swarm.Address, count int) ([]swarm.Chunk, error) {
+	g, err := bee.NewDatasetGenerator(bee.DatasetOptions{
+		Seed:         rnd.Int63(),
+		Distribution: bee.DistributionProximity,
+		Targets:      []swarm.Address{target},
+		PayloadSize:  swarm.ChunkSize,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("dataset generator: %w", err)
+	}
+
+	return g.Chunks(count)
+}
@@ -0,0 +1,293 @@
+package postage
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/ethersphere/bee/pkg/cac"
+	"github.com/ethersphere/bee/pkg/swarm"
+	"github.com/ethersphere/beekeeper/pkg/bee"
+	"github.com/ethersphere/beekeeper/pkg/beeclient/api"
+	"github.com/ethersphere/beekeeper/pkg/random"
+)
+
+// Options represents postage check options
+type Options struct {
+	Seed          int64
+	PostageAmount int64
+	PostageWait   time.Duration
+	ExpiryAmount  int64
+	ExpiryTimeout time.Duration
+	RetryDelay    time.Duration
+	SyncWait      time.Duration
+}
+
+var (
+	errStampAccepted = errors.New("upload with invalid stamp accepted")
+	errStampError    = errors.New("unexpected upload error")
+	errPropagated    = errors.New("rejected chunk propagated")
+)
+
+// Check uploads chunks with invalid postage stamps and checks that uploads
+// are rejected with expected errors and that rejected chunks do not
+// propagate through the network. Cases are: nonexistent batch, batch owned
+// by another node, batch with full bucket and expired batch.
+func Check(c *bee.Cluster, o Options) (err error) {
+	ctx := context.Background()
+	rnd := random.PseudoGenerator(o.Seed)
+	fmt.Println("postage: invalid stamps check")
+	fmt.Printf("Seed: %d\n", o.Seed)
+
+	clients, err := c.NodesClients(ctx)
+	if err != nil {
+		return err
+	}
+
+	sortedNodes := c.NodeNames()
+	if len(sortedNodes) < 2 {
+		return errors.New("postage check requires at least 2 nodes")
+	}
+	perm := rnd.Perm(len(sortedNodes))
+	uploader, owner := sortedNodes[perm[0]], sortedNodes[perm[1]]
+	client := clients[uploader]
+	fmt.Printf("uploader node %s, other batch owner node %s\n", uploader, owner)
+
+	var rejected []swarm.Chunk
+
+	// CASE 1: nonexistent batch
+	id := make([]byte, 32)
+	if _, err := rnd.Read(id); err != nil {
+		return fmt.Errorf("create batch id: %w", err)
+	}
+	ch, err := randomChunk(rnd)
+	if err != nil {
+		return err
+	}
+	if err := expectBadRequest(ctx, client, ch, hex.EncodeToString(id)); err != nil {
+		return fmt.Errorf("node %s: nonexistent batch: %w", uploader, err)
+	}
+	rejected = append(rejected, ch)
+	fmt.Printf("node %s: upload with nonexistent batch rejected\n", uploader)
+
+	// CASE 2: batch owned by another node
+	otherBatchID, err := clients[owner].Batches().Get(ctx, bee.BatchOptions{
+		Amount: o.PostageAmount,
+		Depth:  bee.MinimumBatchDepth,
+		Label:  "test-label",
+		Chunks: 1,
+		Wait:   o.PostageWait,
+	})
+	if err != nil {
+		return fmt.Errorf("node %s: batch id %w", owner, err)
+	}
+	if ch, err = randomChunk(rnd); err != nil {
+		return err
+	}
+	if err := expectBadRequest(ctx, client, ch, otherBatchID); err != nil {
+		return fmt.Errorf("node %s: batch %s of node %s: %w", uploader, otherBatchID, owner, err)
+	}
+	rejected = append(rejected, ch)
+	fmt.Printf("node %s: upload with batch %s of node %s rejected\n", uploader, otherBatchID, owner)
+
+	// CASE 3: batch with full bucket
+	fullChunk, err := overissue(ctx, client, rnd, o)
+	if err != nil {
+		return fmt.Errorf("node %s: full bucket: %w", uploader, err)
+	}
+	rejected = append(rejected, fullChunk)
+	fmt.Printf("node %s: upload to full bucket rejected\n", uploader)
+
+	// CASE 4: expired batch
+	expiredBatchID, err := expiredBatch(ctx, client, o)
+	if err != nil {
+		return fmt.Errorf("node %s: %w", uploader, err)
+	}
+	if ch, err = randomChunk(rnd); err != nil {
+		return err
+	}
+	if err := expectBadRequest(ctx, client, ch, expiredBatchID); err != nil {
+		return fmt.Errorf("node %s: expired batch %s: %w", uploader, expiredBatchID, err)
+	}
+	rejected = append(rejected, ch)
+	fmt.Printf("node %s: upload with expired batch %s rejected\n", uploader, expiredBatchID)
+
+	// rejected chunks must not be pushed to the network
+	time.Sleep(o.SyncWait)
+	for _, n := range sortedNodes {
+		for _, ch := range rejected {
+			has, err := clients[n].HasChunk(ctx, ch.Address())
+			if err != nil {
+				return fmt.Errorf("node %s: %w", n, err)
+			}
+			if has {
+				return fmt.Errorf("node %s: %w: %s", n, errPropagated, ch.Address())
+			}
+		}
+	}
+	fmt.Printf("%d rejected chunks not found on any node\n", len(rejected))
+
+	fmt.Println("postage check completed successfully")
+	return
+}
+
+// expectBadRequest uploads chunk with a given batch and checks that the upload
+// is rejected as a bad request
+func expectBadRequest(ctx context.Context, client *bee.Client, ch swarm.Chunk, batchID string) error {
+	_, err := client.UploadChunk(ctx, ch.Data(), api.UploadOptions{BatchID: batchID})
+	if err == nil {
+		return errStampAccepted
+	}
+
+	var badRequest *api.BadRequestError
+	if !errors.As(err, &badRequest) {
+		return fmt.Errorf("%w: %v, want bad request", errStampError, err)
+	}
+
+	return nil
+}
+
+// overissue creates a batch one level deeper than its buckets, fills one of
+// its buckets with chunks and checks that the next chunk in the same bucket
+// is rejected; it returns the rejected chunk
+func overissue(ctx context.Context, client *bee.Client, rnd *rand.Rand, o Options) (swarm.Chunk, error) {
+	bucketDepth, err := bucketDepth(ctx, client, o)
+	if err != nil {
+		return nil, err
+	}
+
+	depth := uint64(bucketDepth) + 1
+	batchID, err := client.CreatePostageBatch(ctx, o.PostageAmount, depth, "test-label")
+	if err != nil {
+		return nil, fmt.Errorf("create batch: %w", err)
+	}
+	if err := client.WaitPostageBatch(ctx, batchID, o.PostageWait); err != nil {
+		return nil, err
+	}
+	fmt.Printf("created batch %s with depth %d and bucket depth %d\n", batchID, depth, bucketDepth)
+
+	slots := 1 << (depth - uint64(bucketDepth))
+	chunks, err := bucketChunks(rnd, slots+1, bucketDepth)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ch := range chunks[:slots] {
+		if _, err := client.UploadChunk(ctx, ch.Data(), api.UploadOptions{BatchID: batchID}); err != nil {
+			return nil, fmt.Errorf("fill bucket: %w", err)
+		}
+	}
+
+	ch := chunks[slots]
+	_, err = client.UploadChunk(ctx, ch.Data(), api.UploadOptions{BatchID: batchID})
+	if err == nil {
+		return nil, errStampAccepted
+	}
+	if !errors.Is(err, api.ErrPaymentRequired) {
+		return nil, fmt.Errorf("%w: %v, want %v", errStampError, err, api.ErrPaymentRequired)
+	}
+
+	return ch, nil
+}
+
+// bucketDepth returns bucket depth of node's batches
+func bucketDepth(ctx context.Context, client *bee.Client, o Options) (uint8, error) {
+	batchID, err := client.Batches().Get(ctx, bee.BatchOptions{
+		Amount: o.PostageAmount,
+		Depth:  bee.MinimumBatchDepth,
+		Label:  "test-label",
+		Wait:   o.PostageWait,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("batch id %w", err)
+	}
+
+	batches, err := client.PostageBatches(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("get batches: %w", err)
+	}
+	for _, b := range batches {
+		if b.BatchID == batchID {
+			return b.BucketDepth, nil
+		}
+	}
+
+	return 0, fmt.Errorf("batch %s not found", batchID)
+}
+
+// expiredBatch creates a batch with a low amount, waits until the node lists
+// it as usable and then until it expires, so that the batch is rejected
+// because it expired and not because it is not known yet
+func expiredBatch(ctx context.Context, client *bee.Client, o Options) (string, error) {
+	batchID, err := client.CreatePostageBatch(ctx, o.ExpiryAmount, bee.MinimumBatchDepth, "test-label")
+	if err != nil {
+		return "", fmt.Errorf("create batch: %w", err)
+	}
+	if err := client.WaitPostageBatch(ctx, batchID, o.PostageWait); err != nil {
+		return "", err
+	}
+	fmt.Printf("created batch %s with amount %d\n", batchID, o.ExpiryAmount)
+
+	ctx, cancel := context.WithTimeout(ctx, o.ExpiryTimeout)
+	defer cancel()
+
+	for {
+		select {
+		case <-time.After(o.RetryDelay):
+		case <-ctx.Done():
+			return "", fmt.Errorf("batch %s not expired within %s", batchID, o.ExpiryTimeout)
+		}
+
+		batches, err := client.PostageBatches(ctx)
+		if err != nil {
+			fmt.Printf("get batches: %v\n", err)
+			continue
+		}
+
+		expired := true
+		for _, b := range batches {
+			if b.BatchID == batchID && b.Usable {
+				expired = false
+				break
+			}
+		}
+		if expired {
+			return batchID, nil
+		}
+	}
+}
+
+// randomChunk returns chunk with small random payload
+func randomChunk(rnd *rand.Rand) (swarm.Chunk, error) {
+	data := make([]byte, 8)
+	if _, err := rnd.Read(data); err != nil {
+		return nil, fmt.Errorf("create random data: %w", err)
+	}
+
+	ch, err := cac.New(data)
+	if err != nil {
+		return nil, fmt.Errorf("create chunk: %w", err)
+	}
+
+	return ch, nil
+}
+
+// bucketChunks returns count chunks that fall into the same postage bucket
+func bucketChunks(rnd *rand.Rand, count int, bucketDepth uint8) ([]swarm.Chunk, error) {
+	chunks := make([]swarm.Chunk, 0, count)
+	for len(chunks) < count {
+		ch, err := randomChunk(rnd)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(chunks) == 0 || swarm.Proximity(ch.Address().Bytes(), chunks[0].Address().Bytes()) >= bucketDepth {
+			chunks = append(chunks, ch)
+		}
+	}
+
+	return chunks, nil
+}
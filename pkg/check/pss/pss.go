@@ -67,7 +67,13 @@ func Check(c *bee.Cluster, o Options, pusher *push.Pusher, pushMetrics bool) (er
 			return err
 		}
 
-		batchID, err := nodeA.GetOrCreateBatch(ctx, o.PostageDepth, o.PostageWait)
+		batchID, err := nodeA.Batches().Get(ctx, bee.BatchOptions{
+			Amount: o.PostageAmount,
+			Depth:  o.PostageDepth,
+			Label:  "test-label",
+			Chunks: 1,
+			Wait:   o.PostageWait,
+		})
 		if err != nil {
 			cancel()
 			return fmt.Errorf("node %s: batched id %w", nodeAName, err)
@@ -21,6 +21,8 @@ type Options struct {
 	Seed                       int64
 	PostageAmount              int64
 	PostageWait                time.Duration
+	Distribution               bee.Distribution // distribution of chunks relative to node overlays in name order
+	DistributionDepth          uint8
 }
 
 var errPullSync = errors.New("pull sync")
@@ -51,19 +53,40 @@ func Check(c *bee.Cluster, o Options) (err error) {
 	}
 
 	sortedNodes := c.NodeNames()
+	targets := make([]swarm.Address, 0, len(sortedNodes))
+	for _, n := range sortedNodes {
+		targets = append(targets, overlays[n])
+	}
+
 	for i := 0; i < o.UploadNodeCount; i++ {
 
 		nodeName := sortedNodes[i]
 		client := clients[nodeName]
 
-		batchID, err := client.CreatePostageBatch(ctx, o.PostageAmount, bee.MinimumBatchDepth, "test-label")
+		dataset, err := bee.NewDatasetGenerator(bee.DatasetOptions{
+			Seed:         rnds[i].Int63(),
+			Distribution: o.Distribution,
+			Targets:      targets,
+			Depth:        o.DistributionDepth,
+			PayloadSize:  swarm.ChunkSize,
+		})
 		if err != nil {
-			return fmt.Errorf("node %s: created batched id %w", nodeName, err)
+			return fmt.Errorf("node %s: dataset generator: %w", nodeName, err)
 		}
 
-		fmt.Printf("node %s: created batched id %s\n", nodeName, batchID)
+		batchID, err := client.Batches().Get(ctx, bee.BatchOptions{
+			Amount: o.PostageAmount,
+			Depth:  bee.MinimumBatchDepth,
+			Label:  "test-label",
+			Chunks: int64(o.ChunksPerNode),
+			Prefix: dataset.Prefix(),
+			Wait:   o.PostageWait,
+		})
+		if err != nil {
+			return fmt.Errorf("node %s: batch id %w", nodeName, err)
+		}
 
-		time.Sleep(o.PostageWait)
+		fmt.Printf("node %s: batch id %s\n", nodeName, batchID)
 
 		for j := 0; j < o.ChunksPerNode; j++ {
 			var (
@@ -73,10 +96,11 @@ func Check(c *bee.Cluster, o Options) (err error) {
 			)
 			replicatingNodes := make(map[string]swarm.Address)
 
-			chunk, err = bee.NewRandomChunk(rnds[i])
+			ch, err := dataset.Chunk()
 			if err != nil {
 				return fmt.Errorf("node %s: %w", nodeName, err)
 			}
+			chunk = bee.NewChunk(ch)
 
 			addr, err := client.UploadChunk(ctx, chunk.Data(), api.UploadOptions{BatchID: batchID})
 			if err != nil {
@@ -33,7 +33,13 @@ func CheckChunks(c *bee.Cluster, o Options) error {
 
 		uploader := clients[nodeName]
 
-		batchID, err := uploader.GetOrCreateBatch(ctx, o.PostageDepth, o.PostageWait)
+		batchID, err := uploader.Batches().Get(ctx, bee.BatchOptions{
+			Amount: o.PostageAmount,
+			Depth:  o.PostageDepth,
+			Label:  "test-label",
+			Chunks: int64(o.ChunksPerNode),
+			Wait:   o.PostageWait,
+		})
 		if err != nil {
 			return fmt.Errorf("node %s: batch id %w", nodeName, err)
 		}
@@ -0,0 +1,128 @@
+package reconnect
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethersphere/bee/pkg/swarm"
+	"github.com/ethersphere/beekeeper/pkg/bee"
+	"github.com/ethersphere/beekeeper/pkg/random"
+)
+
+// Options represents reconnect check options
+type Options struct {
+	Seed       int64
+	Timeout    time.Duration
+	RetryDelay time.Duration
+}
+
+var (
+	errNoNeighbours = errors.New("no connected peers at proximity order >= depth")
+	errNotRecovered = errors.New("kademlia not recovered")
+)
+
+// Check disconnects a random node from its neighbourhood peers and checks
+// that Kademlia reconnects them and that node's depth recovers within timeout
+func Check(c *bee.Cluster, o Options) (err error) {
+	ctx := context.Background()
+	rnd := random.PseudoGenerator(o.Seed)
+	fmt.Println("reconnect: neighbourhood check")
+	fmt.Printf("Seed: %d\n", o.Seed)
+
+	node, err := c.RandomNode(ctx, rnd)
+	if err != nil {
+		return fmt.Errorf("random node: %w", err)
+	}
+	client := node.Client()
+
+	topology, err := client.Topology(ctx)
+	if err != nil {
+		return fmt.Errorf("node %s: %w", node.Name(), err)
+	}
+	fmt.Printf("node %s: depth %d, connected %d\n", node.Name(), topology.Depth, topology.Connected)
+
+	neighbours, err := neighbourhood(topology)
+	if err != nil {
+		return fmt.Errorf("node %s: %w", node.Name(), err)
+	}
+	if len(neighbours) == 0 {
+		return fmt.Errorf("node %s: %w", node.Name(), errNoNeighbours)
+	}
+
+	for _, p := range neighbours {
+		if err := client.Disconnect(ctx, p); err != nil {
+			return fmt.Errorf("node %s: %w", node.Name(), err)
+		}
+	}
+	start := time.Now()
+	fmt.Printf("node %s: disconnected from %d neighbourhood peers\n", node.Name(), len(neighbours))
+
+	blocklist, err := client.Blocklist(ctx)
+	if err != nil {
+		return fmt.Errorf("node %s: %w", node.Name(), err)
+	}
+	for _, p := range neighbours {
+		if p.MemberOf(blocklist) {
+			return fmt.Errorf("node %s: disconnected peer %s is blocklisted", node.Name(), p)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, o.Timeout)
+	defer cancel()
+
+	for {
+		select {
+		case <-time.After(o.RetryDelay):
+		case <-ctx.Done():
+			return fmt.Errorf("node %s: %w within %s", node.Name(), errNotRecovered, o.Timeout)
+		}
+
+		t, err := client.Topology(ctx)
+		if err != nil {
+			fmt.Printf("node %s: %v\n", node.Name(), err)
+			continue
+		}
+
+		peers, err := client.Peers(ctx)
+		if err != nil {
+			fmt.Printf("node %s: %v\n", node.Name(), err)
+			continue
+		}
+
+		var reconnected int
+		for _, p := range neighbours {
+			if p.MemberOf(peers) {
+				reconnected++
+			}
+		}
+		fmt.Printf("node %s: reconnected %d/%d, depth %d/%d\n", node.Name(), reconnected, len(neighbours), t.Depth, topology.Depth)
+
+		if reconnected == len(neighbours) && t.Depth >= topology.Depth {
+			break
+		}
+	}
+
+	fmt.Printf("node %s: kademlia recovered in %s\n", node.Name(), time.Since(start))
+	fmt.Println("reconnect check completed successfully")
+	return
+}
+
+// neighbourhood returns connected peers in bins at proximity order >= depth
+func neighbourhood(t bee.Topology) (peers []swarm.Address, err error) {
+	for k, b := range t.Bins {
+		po, err := strconv.Atoi(strings.Split(k, "_")[1])
+		if err != nil {
+			return nil, fmt.Errorf("bin %s: %w", k, err)
+		}
+
+		if po >= t.Depth {
+			peers = append(peers, b.ConnectedPeers...)
+		}
+	}
+
+	return
+}
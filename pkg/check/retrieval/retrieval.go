@@ -58,7 +58,13 @@ func Check(c *bee.Cluster, o Options, pusher *push.Pusher, pushMetrics bool) (er
 		nodeName := sortedNodes[i]
 		client := clients[nodeName]
 
-		batchID, err := client.GetOrCreateBatch(ctx, o.PostageDepth, o.PostageWait)
+		batchID, err := client.Batches().Get(ctx, bee.BatchOptions{
+			Amount: o.PostageAmount,
+			Depth:  o.PostageDepth,
+			Label:  "test-label",
+			Chunks: int64(o.ChunksPerNode),
+			Wait:   o.PostageWait,
+		})
 		if err != nil {
 			return fmt.Errorf("node %s: batch id %w", nodeName, err)
 		}
@@ -66,12 +66,18 @@ func Check(c *bee.Cluster, o Options, pusher *push.Pusher, pushMetrics bool) (er
 		// upload file to random node
 		uIndex := rnd.Intn(c.Size())
 		uNode := sortedNodes[uIndex]
-		file := bee.NewRandomFile(rnd, fmt.Sprintf("%s-%d", o.FileName, uIndex), o.FileSize)
+		file := bee.NewRandomFileStream(rnd.Int63(), fmt.Sprintf("%s-%d", o.FileName, uIndex), o.FileSize)
 
 		client := clients[uNode]
 
 		fmt.Println("node", uNode)
-		batchID, err := client.GetOrCreateBatch(ctx, o.PostageDepth, o.PostageWait)
+		batchID, err := client.Batches().Get(ctx, bee.BatchOptions{
+			Amount: o.PostageAmount,
+			Depth:  o.PostageDepth,
+			Label:  "test-label",
+			Chunks: bee.CalculateNumberOfChunks(file.Size(), false),
+			Wait:   o.PostageWait,
+		})
 		if err != nil {
 			return fmt.Errorf("node %s: batch id %w", uNode, err)
 		}
@@ -20,6 +20,7 @@ type Options struct {
 	Bytes           int // how many bytes to upload each time
 	Timeout         time.Duration
 	Seed            int64
+	Encrypt         bool
 }
 
 // Check uploads given chunks on cluster and checks pushsync ability of the cluster
@@ -49,10 +50,13 @@ func Check(c *bee.Cluster, o Options) error {
 			return fmt.Errorf("create random data: %w", err)
 		}
 
-		addr, err := ng.NodeClient(nodeName).UploadBytes(ctx, data, api.UploadOptions{Pin: false, Tag: tr.Uid})
+		addr, err := ng.NodeClient(nodeName).UploadBytes(ctx, data, api.UploadOptions{Pin: false, Tag: tr.Uid, Encrypt: o.Encrypt})
 		if err != nil {
 			return fmt.Errorf("upload to node %s: %w", nodeName, err)
 		}
+		if bee.IsEncryptedReference(addr) != o.Encrypt {
+			return fmt.Errorf("upload to node %s: reference %s: %w", nodeName, addr, bee.ErrReferenceType)
+		}
 
 		ctx, cancel := context.WithTimeout(ctx, o.Timeout)
 		defer cancel()
@@ -78,7 +78,13 @@ func Check(c *bee.Cluster, o Options, pusher *push.Pusher, pushMetrics bool) err
 	id := hex.EncodeToString(idBytes)
 	sig := hex.EncodeToString(signatureBytes)
 
-	batchID, err := node.GetOrCreateBatch(ctx, o.PostageDepth, o.PostageWait)
+	batchID, err := node.Batches().Get(ctx, bee.BatchOptions{
+		Amount: o.PostageAmount,
+		Depth:  o.PostageDepth,
+		Label:  "test-label",
+		Chunks: 1,
+		Wait:   o.PostageWait,
+	})
 	if err != nil {
 		return fmt.Errorf("node %s: batch id %w", nodeName, err)
 	}
@@ -0,0 +1,221 @@
+package tags
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethersphere/bee/pkg/swarm"
+	"github.com/ethersphere/beekeeper/pkg/bee"
+	"github.com/ethersphere/beekeeper/pkg/beeclient/api"
+	"github.com/ethersphere/beekeeper/pkg/random"
+)
+
+// Options represents tags check options
+type Options struct {
+	DataSize      int64
+	Encrypt       bool
+	Seed          int64
+	PostageAmount int64
+	PostageDepth  uint64
+	PostageWait   time.Duration
+	SyncTimeout   time.Duration
+}
+
+var errTagCounter = errors.New("tag counter")
+
+// tagsPageSize is the number of tags requested from a node at once
+const tagsPageSize = 100
+
+// Check uploads content of known size under a tag and checks that tag's
+// counters match the expected number of chunks. It uploads the same content
+// again under another tag to check the seen counter, and checks that tags
+// are listed and deleted
+func Check(c *bee.Cluster, o Options) (err error) {
+	ctx := context.Background()
+	rnd := random.PseudoGenerator(o.Seed)
+	fmt.Println("tags: counters check")
+	fmt.Printf("Seed: %d\n", o.Seed)
+
+	node, err := c.RandomNode(ctx, rnd)
+	if err != nil {
+		return fmt.Errorf("random node: %w", err)
+	}
+	client := node.Client()
+
+	batchID, err := client.Batches().Get(ctx, bee.BatchOptions{
+		Amount: o.PostageAmount,
+		Depth:  o.PostageDepth,
+		Label:  "test-label",
+		Chunks: 2 * bee.CalculateNumberOfChunks(o.DataSize, o.Encrypt),
+		Wait:   o.PostageWait,
+	})
+	if err != nil {
+		return fmt.Errorf("node %s: batch id %w", node.Name(), err)
+	}
+	fmt.Printf("node %s: batch id %s\n", node.Name(), batchID)
+
+	data := make([]byte, o.DataSize)
+	if _, err := rnd.Read(data); err != nil {
+		return fmt.Errorf("create random data: %w", err)
+	}
+	chunks := bee.CalculateNumberOfChunks(o.DataSize, o.Encrypt)
+
+	// STEP 1: upload new content and wait for it to sync
+	tag, err := client.CreateTag(ctx)
+	if err != nil {
+		return fmt.Errorf("node %s: %w", node.Name(), err)
+	}
+
+	ref, err := client.UploadBytes(ctx, data, api.UploadOptions{Tag: tag.Uid, BatchID: batchID, Encrypt: o.Encrypt})
+	if err != nil {
+		return fmt.Errorf("node %s: %w", node.Name(), err)
+	}
+	fmt.Printf("node %s: uploaded %d bytes with reference %s under tag %d\n", node.Name(), o.DataSize, ref, tag.Uid)
+
+	syncCtx, cancel := context.WithTimeout(ctx, o.SyncTimeout)
+	err = client.WaitSync(syncCtx, tag.Uid)
+	cancel()
+	if err != nil {
+		return fmt.Errorf("node %s: tag %d: %w", node.Name(), tag.Uid, err)
+	}
+
+	tag, err = client.GetTag(ctx, tag.Uid)
+	if err != nil {
+		return fmt.Errorf("node %s: %w", node.Name(), err)
+	}
+	fmt.Printf("node %s: tag %d: %s\n", node.Name(), tag.Uid, counters(tag))
+
+	if err := checkCounters(tag, ref, map[string]int64{
+		"total":  chunks,
+		"split":  chunks,
+		"stored": chunks,
+		"seen":   0,
+		"sent":   chunks,
+		"synced": chunks,
+	}); err != nil {
+		return fmt.Errorf("node %s: tag %d: %w", node.Name(), tag.Uid, err)
+	}
+
+	// STEP 2: upload the same content again, all chunks must be seen
+	seenTag, err := client.CreateTag(ctx)
+	if err != nil {
+		return fmt.Errorf("node %s: %w", node.Name(), err)
+	}
+
+	if _, err := client.UploadBytes(ctx, data, api.UploadOptions{Tag: seenTag.Uid, BatchID: batchID, Encrypt: o.Encrypt}); err != nil {
+		return fmt.Errorf("node %s: %w", node.Name(), err)
+	}
+	fmt.Printf("node %s: uploaded the same %d bytes under tag %d\n", node.Name(), o.DataSize, seenTag.Uid)
+
+	seenTag, err = client.GetTag(ctx, seenTag.Uid)
+	if err != nil {
+		return fmt.Errorf("node %s: %w", node.Name(), err)
+	}
+	fmt.Printf("node %s: tag %d: %s\n", node.Name(), seenTag.Uid, counters(seenTag))
+
+	// encrypted content is encrypted with a random key on every upload,
+	// so it is never seen
+	seen := chunks
+	if o.Encrypt {
+		seen = 0
+	}
+	if err := checkCounters(seenTag, swarm.ZeroAddress, map[string]int64{
+		"split":  chunks,
+		"stored": chunks,
+		"seen":   seen,
+	}); err != nil {
+		return fmt.Errorf("node %s: tag %d: %w", node.Name(), seenTag.Uid, err)
+	}
+
+	// STEP 3: list and delete tags
+	uids := []uint32{tag.Uid, seenTag.Uid}
+	listed, err := listTags(ctx, client)
+	if err != nil {
+		return fmt.Errorf("node %s: %w", node.Name(), err)
+	}
+	for _, uid := range uids {
+		if _, ok := listed[uid]; !ok {
+			return fmt.Errorf("node %s: tag %d not listed", node.Name(), uid)
+		}
+	}
+	fmt.Printf("node %s: tags %v listed\n", node.Name(), uids)
+
+	for _, uid := range uids {
+		if err := client.DeleteTag(ctx, uid); err != nil {
+			return fmt.Errorf("node %s: tag %d: %w", node.Name(), uid, err)
+		}
+
+		if _, err := client.GetTag(ctx, uid); !errors.Is(err, api.ErrNotFound) {
+			return fmt.Errorf("node %s: deleted tag %d: got error %v, want %v", node.Name(), uid, err, api.ErrNotFound)
+		}
+	}
+
+	listed, err = listTags(ctx, client)
+	if err != nil {
+		return fmt.Errorf("node %s: %w", node.Name(), err)
+	}
+	for _, uid := range uids {
+		if _, ok := listed[uid]; ok {
+			return fmt.Errorf("node %s: deleted tag %d listed", node.Name(), uid)
+		}
+	}
+	fmt.Printf("node %s: tags %v deleted\n", node.Name(), uids)
+
+	fmt.Println("tags check completed successfully")
+	return
+}
+
+// checkCounters compares tag's counters with the expected ones and, if
+// reference is not zero address, tag's address with the reference
+func checkCounters(tag api.TagResponse, ref swarm.Address, want map[string]int64) error {
+	have := map[string]int64{
+		"total":  tag.Total,
+		"split":  tag.Split,
+		"stored": tag.Stored,
+		"seen":   tag.Seen,
+		"sent":   tag.Sent,
+		"synced": tag.Synced,
+	}
+
+	for _, name := range []string{"total", "split", "stored", "seen", "sent", "synced"} {
+		w, ok := want[name]
+		if !ok {
+			continue
+		}
+		if have[name] != w {
+			return fmt.Errorf("%w: %s: have %d; want %d", errTagCounter, name, have[name], w)
+		}
+	}
+
+	if !ref.Equal(swarm.ZeroAddress) && !tag.Address.Equal(ref) {
+		return fmt.Errorf("tag address: have %s; want %s", tag.Address, ref)
+	}
+
+	return nil
+}
+
+// counters returns printable tag's counters
+func counters(tag api.TagResponse) string {
+	return fmt.Sprintf("total %d, split %d, stored %d, seen %d, sent %d, synced %d", tag.Total, tag.Split, tag.Stored, tag.Seen, tag.Sent, tag.Synced)
+}
+
+// listTags returns all node's tags by uid
+func listTags(ctx context.Context, client *bee.Client) (map[uint32]api.TagResponse, error) {
+	tags := make(map[uint32]api.TagResponse)
+	for offset := 0; ; offset += tagsPageSize {
+		page, err := client.ListTags(ctx, offset, tagsPageSize)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, t := range page {
+			tags[t.Uid] = t
+		}
+
+		if len(page) < tagsPageSize {
+			return tags, nil
+		}
+	}
+}
@@ -0,0 +1,268 @@
+package website
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"mime"
+	"path"
+	"sort"
+	"time"
+
+	"github.com/ethersphere/bee/pkg/swarm"
+	"github.com/ethersphere/beekeeper/pkg/bee"
+	"github.com/ethersphere/beekeeper/pkg/beeclient/api"
+	"github.com/ethersphere/beekeeper/pkg/random"
+)
+
+// Options represents website check options
+type Options struct {
+	FilesInCollection int
+	MaxDirectoryDepth int
+	MaxFileSize       int64
+	Seed              int64
+	PostageAmount     int64
+	PostageDepth      uint64
+	PostageWait       time.Duration
+	Retries           int
+	RetryDelay        time.Duration
+}
+
+const (
+	indexDocument = "index.html"
+	errorDocument = "404.html"
+)
+
+var (
+	errWebsiteContent     = errors.New("website content mismatch")
+	errWebsiteContentType = errors.New("website content type mismatch")
+)
+
+// pathSegments are directory and file names of generated websites, they
+// include unicode, spaces and percent signs that must be escaped in requests
+var pathSegments = []string{
+	"assets",
+	"static",
+	"ünïcödé",
+	"文档",
+	"emoji-🐝",
+	"with space",
+	"percent%20encoded",
+	"plus+sign",
+}
+
+// contentTypes are media types of file extensions that are detected by the
+// node on upload; files with other extensions are not checked for content type
+var contentTypes = map[string]string{
+	".html": "text/html",
+	".css":  "text/css",
+	".json": "application/json",
+	".svg":  "image/svg+xml",
+	".png":  "image/png",
+	".xml":  "text/xml",
+	".js":   "",
+	".bin":  "",
+	"":      "",
+}
+
+// Check uploads a website-like collection with nested directories, unicode
+// and percent-encoded paths, mixed content types and index and error
+// documents to a random node, and downloads it from another node. It checks
+// file contents and content types, index document resolution of directories
+// and error document fallback for paths that do not exist.
+func Check(c *bee.Cluster, o Options) (err error) {
+	if o.MaxFileSize <= 0 {
+		return fmt.Errorf("max file size must be greater than 0")
+	}
+	if o.MaxDirectoryDepth < 0 {
+		return fmt.Errorf("max directory depth must not be negative")
+	}
+
+	ctx := context.Background()
+	rnd := random.PseudoGenerator(o.Seed)
+	fmt.Println("website: collection check")
+	fmt.Printf("Seed: %d\n", o.Seed)
+
+	clients, err := c.NodesClients(ctx)
+	if err != nil {
+		return err
+	}
+
+	sortedNodes := c.NodeNames()
+	perm := rnd.Perm(len(sortedNodes))
+	uploader, downloader := sortedNodes[perm[0]], sortedNodes[perm[len(perm)-1]]
+
+	w, err := generateWebsite(rnd, o)
+	if err != nil {
+		return err
+	}
+
+	collection, err := bee.NewCollectionFile("", w.files)
+	if err != nil {
+		return fmt.Errorf("create collection: %w", err)
+	}
+
+	client := clients[uploader]
+	batchID, err := client.Batches().Get(ctx, bee.BatchOptions{
+		Amount: o.PostageAmount,
+		Depth:  o.PostageDepth,
+		Label:  "test-label",
+		Chunks: bee.CalculateNumberOfChunks(collection.Size(), false) + int64(len(w.files)),
+		Wait:   o.PostageWait,
+	})
+	if err != nil {
+		return fmt.Errorf("node %s: batch id %w", uploader, err)
+	}
+	fmt.Printf("node %s: batch id %s\n", uploader, batchID)
+
+	if err := client.UploadCollection(ctx, &collection, api.UploadOptions{
+		BatchID:       batchID,
+		IndexDocument: indexDocument,
+		ErrorDocument: errorDocument,
+	}); err != nil {
+		return fmt.Errorf("node %s: %w", uploader, err)
+	}
+	ref := collection.Address()
+	fmt.Printf("node %s: uploaded website with %d files and %d directories, reference %s\n", uploader, len(w.files), len(w.dirs), ref)
+
+	client = clients[downloader]
+
+	// files by their paths
+	for _, f := range w.files {
+		want := expectation{file: f, contentType: contentTypes[path.Ext(f.Name())]}
+		if err := retry(o, func() error { return checkPath(ctx, client, ref, f.Name(), want) }); err != nil {
+			return fmt.Errorf("node %s: %w", downloader, err)
+		}
+	}
+	fmt.Printf("node %s: %d files retrieved\n", downloader, len(w.files))
+
+	// directories resolve to their index documents
+	for _, dir := range w.dirs {
+		want := expectation{file: w.indexes[dir], contentType: contentTypes[".html"]}
+		p := ""
+		if dir != "" {
+			p = dir + "/"
+		}
+		if err := retry(o, func() error { return checkPath(ctx, client, ref, p, want) }); err != nil {
+			return fmt.Errorf("node %s: directory %q: %w", downloader, dir, err)
+		}
+	}
+	fmt.Printf("node %s: %d directories resolved to index documents\n", downloader, len(w.dirs))
+
+	// paths that do not exist fall back to the error document
+	want := expectation{file: w.notFound, contentType: contentTypes[".html"]}
+	for _, dir := range w.dirs {
+		p := path.Join(dir, fmt.Sprintf("not-found-%d.html", rnd.Int()))
+		if err := retry(o, func() error { return checkPath(ctx, client, ref, p, want) }); err != nil {
+			return fmt.Errorf("node %s: missing path %q: %w", downloader, p, err)
+		}
+	}
+	fmt.Printf("node %s: %d missing paths resolved to error document\n", downloader, len(w.dirs))
+
+	fmt.Println("website check completed successfully")
+	return
+}
+
+// website represents generated website collection
+type website struct {
+	files    []bee.File
+	dirs     []string            // all directories, root is an empty string
+	indexes  map[string]bee.File // index documents by directory
+	notFound bee.File
+}
+
+// generateWebsite generates website with files in nested directories, an
+// index document in every directory and an error document in the root
+func generateWebsite(rnd *rand.Rand, o Options) (w website, err error) {
+	exts := make([]string, 0, len(contentTypes))
+	for ext := range contentTypes {
+		exts = append(exts, ext)
+	}
+	sort.Strings(exts)
+
+	dirs := map[string]struct{}{"": {}}
+	var files []bee.File
+	for i := 0; i < o.FilesInCollection; i++ {
+		dir := ""
+		for d := rnd.Intn(o.MaxDirectoryDepth + 1); d > 0; d-- {
+			dir = path.Join(dir, pathSegments[rnd.Intn(len(pathSegments))])
+			dirs[dir] = struct{}{}
+		}
+
+		name := fmt.Sprintf("%s-%d%s", pathSegments[rnd.Intn(len(pathSegments))], i, exts[rnd.Intn(len(exts))])
+		files = append(files, bee.NewRandomFile(rnd, path.Join(dir, name), rnd.Int63n(o.MaxFileSize)+1))
+	}
+
+	w.indexes = make(map[string]bee.File)
+	for dir := range dirs {
+		w.dirs = append(w.dirs, dir)
+	}
+	sort.Strings(w.dirs)
+
+	for _, dir := range w.dirs {
+		f := bee.NewRandomFile(rnd, path.Join(dir, indexDocument), rnd.Int63n(o.MaxFileSize)+1)
+		files = append(files, f)
+	}
+	files = append(files, bee.NewRandomFile(rnd, errorDocument, rnd.Int63n(o.MaxFileSize)+1))
+
+	for i := range files {
+		if err := files[i].CalculateHash(); err != nil {
+			return website{}, fmt.Errorf("file %s: %w", files[i].Name(), err)
+		}
+	}
+
+	w.files = files
+	for i, dir := range w.dirs {
+		w.indexes[dir] = files[o.FilesInCollection+i]
+	}
+	w.notFound = files[len(files)-1]
+
+	return w, nil
+}
+
+// expectation represents expected download of a website path
+type expectation struct {
+	file        bee.File
+	contentType string // empty content type is not checked
+}
+
+// checkPath downloads website path and compares it with the expected file
+func checkPath(ctx context.Context, client *bee.Client, ref swarm.Address, p string, want expectation) error {
+	size, hash, contentType, err := client.DownloadManifestFileWithContentType(ctx, ref, p)
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(hash, want.file.Hash()) {
+		return fmt.Errorf("%w: path %q: downloaded %d bytes; want %s with %d bytes", errWebsiteContent, p, size, want.file.Name(), want.file.Size())
+	}
+
+	if want.contentType == "" {
+		return nil
+	}
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return fmt.Errorf("%w: path %q: parse %q: %v", errWebsiteContentType, p, contentType, err)
+	}
+	if mediaType != want.contentType {
+		return fmt.Errorf("%w: path %q: have %s; want %s", errWebsiteContentType, p, mediaType, want.contentType)
+	}
+
+	return nil
+}
+
+// retry calls f until it succeeds or number of retries is reached
+func retry(o Options, f func() error) (err error) {
+	for i := 0; i <= o.Retries; i++ {
+		if i > 0 {
+			time.Sleep(o.RetryDelay)
+		}
+		if err = f(); err == nil {
+			return nil
+		}
+		fmt.Println(err)
+	}
+	return err
+}
@@ -33,12 +33,12 @@ func NewClient(kubeconfig string, namespace string, resource schema.GroupVersion
 	if kubeconfig == "incluster" {
 		config, err = rest.InClusterConfig()
 		if err != nil {
-			return nil, fmt.Errorf("error parsing incluster kubeconfig: %+v", err)
+			return nil, fmt.Errorf("error parsing incluster kubeconfig: %w", err)
 		}
 	} else {
 		config, err = clientcmd.BuildConfigFromFlags("", kubeconfig)
 		if err != nil {
-			return nil, fmt.Errorf("error parsing kubeconfig file: %+v", err)
+			return nil, fmt.Errorf("error parsing kubeconfig file: %w", err)
 		}
 	}
 	if namespace == "" {
@@ -47,7 +47,7 @@ func NewClient(kubeconfig string, namespace string, resource schema.GroupVersion
 
 	dynClient, err := dynamic.NewForConfig(config)
 	if err != nil {
-		return nil, fmt.Errorf("error creating k8s client: %+v", err)
+		return nil, fmt.Errorf("error creating k8s client: %w", err)
 	}
 	crdClient := dynClient.Resource(resource)
 
@@ -65,7 +65,7 @@ func (c *Client) Create(ctx context.Context, object *unstructured.Unstructured)
 	crdClient := c.crdClient
 	_, err = crdClient.Create(ctx, object, metav1.CreateOptions{})
 	if err != nil {
-		return fmt.Errorf("error creating k8s object: %+v", err)
+		return fmt.Errorf("error creating k8s object: %w", err)
 	}
 	return
 }
@@ -75,12 +75,12 @@ func (c *Client) Update(ctx context.Context, object *unstructured.Unstructured)
 	crdClient := c.crdClient
 	crd, err := crdClient.Get(ctx, object.GetName(), metav1.GetOptions{})
 	if err != nil {
-		return fmt.Errorf("error getting resourceVersion k8s object: %+v", err)
+		return fmt.Errorf("error getting resourceVersion k8s object: %w", err)
 	}
 	object.SetResourceVersion(crd.GetResourceVersion())
 	_, err = crdClient.Update(ctx, object, metav1.UpdateOptions{})
 	if err != nil {
-		return fmt.Errorf("error updating k8s object: %+v", err)
+		return fmt.Errorf("error updating k8s object: %w", err)
 	}
 	return
 }
@@ -90,7 +90,7 @@ func (c *Client) Delete(ctx context.Context, name string) (err error) {
 	crdClient := c.crdClient
 	err = crdClient.Delete(ctx, name, metav1.DeleteOptions{})
 	if err != nil {
-		return fmt.Errorf("error deleting k8s object: %+v", err)
+		return fmt.Errorf("error deleting k8s object: %w", err)
 	}
 	return
 }
@@ -100,7 +100,17 @@ func (c *Client) Get(ctx context.Context, name string) (resp *unstructured.Unstr
 	crdClient := c.crdClient
 	resp, err = crdClient.Get(ctx, name, metav1.GetOptions{})
 	if err != nil {
-		return nil, fmt.Errorf("error creating k8s object: %+v", err)
+		return nil, fmt.Errorf("error getting k8s object: %w", err)
+	}
+	return resp, nil
+}
+
+func (c *Client) List(ctx context.Context, labelSelector string) (resp *unstructured.UnstructuredList, err error) {
+
+	crdClient := c.crdClient
+	resp, err = crdClient.List(ctx, metav1.ListOptions{LabelSelector: labelSelector})
+	if err != nil {
+		return nil, fmt.Errorf("error listing k8s objects: %w", err)
 	}
 	return resp, nil
 }
@@ -109,17 +119,17 @@ func (c *Client) Get(ctx context.Context, name string) (resp *unstructured.Unstr
 // 	crdClient := c.crdClient
 // 	crd, err := crdClient.Get(ctx, "bee", metav1.GetOptions{})
 // 	if err != nil {
-// 		return fmt.Errorf("error getting resourceVersion k8s object: %+v", err)
+// 		return fmt.Errorf("error getting resourceVersion k8s object: %w", err)
 // 	}
 
 // 	err = unstructured.SetNestedField(crd.Object, replica, "spec", "replicas")
 // 	if err != nil {
-// 		return fmt.Errorf("error updating k8s object: %+v", err)
+// 		return fmt.Errorf("error updating k8s object: %w", err)
 // 	}
 
 // 	_, err = crdClient.Update(ctx, crd, metav1.UpdateOptions{})
 // 	if err != nil {
-// 		return fmt.Errorf("error updating k8s object: %+v", err)
+// 		return fmt.Errorf("error updating k8s object: %w", err)
 // 	}
 // 	return
 // }
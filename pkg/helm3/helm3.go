@@ -2,30 +2,36 @@ package helm3
 
 import (
 	"fmt"
-	"log"
 	"os"
 	"path/filepath"
+	"time"
 
-	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
 	"helm.sh/helm/v3/pkg/action"
 	"helm.sh/helm/v3/pkg/chart"
 	"helm.sh/helm/v3/pkg/chart/loader"
 	"helm.sh/helm/v3/pkg/cli"
 	"helm.sh/helm/v3/pkg/cli/values"
 	"helm.sh/helm/v3/pkg/getter"
-	"helm.sh/helm/v3/pkg/strvals"
+	"helm.sh/helm/v3/pkg/release"
 
 	// _ "k8s.io/client-go/plugin/pkg/client/auth"
 	"k8s.io/client-go/util/homedir"
 )
 
-var settings *cli.EnvSettings
-
-func Upgrade(kubeconfig string, namespace string, release string, chart string, args map[string]string) (err error) {
-	os.Setenv("HELM_NAMESPACE", namespace)
-	settings = cli.New()
+// Client manages Helm releases in a single namespace
+type Client struct {
+	namespace string
+	settings  *cli.EnvSettings
+	config    *action.Configuration
+}
 
-	if kubeconfig == "" {
+// NewClient returns Helm client for the given kubeconfig and namespace,
+// kubeconfig "incluster" uses in-cluster configuration
+func NewClient(kubeconfig, namespace string) (c *Client, err error) {
+	if kubeconfig == "incluster" {
+		kubeconfig = ""
+	} else if kubeconfig == "" {
 		if home := homedir.HomeDir(); home != "" {
 			kubeconfig = filepath.Join(home, ".kube", "config")
 		} else {
@@ -33,67 +39,228 @@ func Upgrade(kubeconfig string, namespace string, release string, chart string,
 		}
 	}
 
-	os.Setenv("KUBECONFIG", kubeconfig)
+	settings := cli.New()
+	settings.KubeConfig = kubeconfig
+	// namespace is not exported by EnvSettings, it can only be set through its flags
+	flags := pflag.NewFlagSet("helm", pflag.ContinueOnError)
+	settings.AddFlags(flags)
+	if err := flags.Set("namespace", namespace); err != nil {
+		return nil, fmt.Errorf("setting namespace %s: %w", namespace, err)
+	}
+
+	config := new(action.Configuration)
+	if err := config.Init(settings.RESTClientGetter(), namespace, os.Getenv("HELM_DRIVER"), noDebug); err != nil {
+		return nil, fmt.Errorf("initializing helm configuration: %w", err)
+	}
+
+	return &Client{
+		namespace: namespace,
+		settings:  settings,
+		config:    config,
+	}, nil
+}
+
+// Options represents options for installing and upgrading a release
+type Options struct {
+	Version     string                 // chart version, latest if empty
+	Values      map[string]interface{} // base values, overridden by values files and set entries
+	ValuesFiles []string
+	Set         []string
+	ReuseValues bool // upgrade only
+	Wait        bool
+	Timeout     time.Duration
+}
+
+// Release represents Helm release
+type Release struct {
+	Name       string
+	Namespace  string
+	Revision   int
+	Status     string
+	Chart      string
+	Version    string // chart version
+	AppVersion string
+	Updated    time.Time
+}
+
+// Install installs chart as a new release
+func (c *Client) Install(release, chart string, o Options) (r Release, err error) {
+	if release == "" {
+		return Release{}, fmt.Errorf("release has to be specified")
+	}
+
+	client := action.NewInstall(c.config)
+	client.Namespace = c.namespace
+	client.ReleaseName = release
+	client.Version = o.Version
+	client.Wait = o.Wait
+	client.Timeout = o.Timeout
 
+	ch, vals, err := c.load(&client.ChartPathOptions, chart, o)
+	if err != nil {
+		return Release{}, err
+	}
+
+	rel, err := client.Run(ch, vals)
+	if err != nil {
+		return Release{}, fmt.Errorf("installing release %s: %w", release, err)
+	}
+
+	return newRelease(rel), nil
+}
+
+// Upgrade upgrades existing release to a new version of the chart
+func (c *Client) Upgrade(release, chart string, o Options) (r Release, err error) {
 	if release == "" {
-		return fmt.Errorf("error release has to be specified")
+		return Release{}, fmt.Errorf("release has to be specified")
+	}
+
+	client := action.NewUpgrade(c.config)
+	client.Namespace = c.namespace
+	client.Version = o.Version
+	client.ReuseValues = o.ReuseValues
+	client.Wait = o.Wait
+	client.Timeout = o.Timeout
+
+	ch, vals, err := c.load(&client.ChartPathOptions, chart, o)
+	if err != nil {
+		return Release{}, err
+	}
+
+	rel, err := client.Run(release, ch, vals)
+	if err != nil {
+		return Release{}, fmt.Errorf("upgrading release %s: %w", release, err)
 	}
 
-	actionConfig := new(action.Configuration)
-	if err := actionConfig.Init(settings.RESTClientGetter(), settings.Namespace(), os.Getenv("HELM_DRIVER"), noDebug); err != nil {
-		log.Fatal(err)
+	return newRelease(rel), nil
+}
+
+// Uninstall removes release and all its resources
+func (c *Client) Uninstall(release string, timeout time.Duration) (err error) {
+	client := action.NewUninstall(c.config)
+	client.Timeout = timeout
+
+	if _, err := client.Run(release); err != nil {
+		return fmt.Errorf("uninstalling release %s: %w", release, err)
+	}
+
+	return
+}
+
+// Rollback rolls release back to the given revision, previous revision if 0
+func (c *Client) Rollback(release string, revision int, wait bool, timeout time.Duration) (err error) {
+	client := action.NewRollback(c.config)
+	client.Version = revision
+	client.Wait = wait
+	client.Timeout = timeout
+
+	if err := client.Run(release); err != nil {
+		return fmt.Errorf("rolling back release %s: %w", release, err)
 	}
-	client := action.NewUpgrade(actionConfig)
 
-	client.Namespace = namespace
-	// client.ReleaseName = release
-	cp, err := client.ChartPathOptions.LocateChart(chart, settings)
+	return
+}
+
+// Status returns status of the release
+func (c *Client) Status(release string) (r Release, err error) {
+	rel, err := action.NewStatus(c.config).Run(release)
 	if err != nil {
-		log.Fatal(err)
+		return Release{}, fmt.Errorf("getting release %s status: %w", release, err)
 	}
 
-	// TODO: Add reuse values and wait as cli arguments
-	client.ReuseValues = true
-	client.Wait = true
+	return newRelease(rel), nil
+}
+
+// List returns all releases in the namespace
+func (c *Client) List() (rs []Release, err error) {
+	client := action.NewList(c.config)
+	client.StateMask = action.ListAll
 
-	p := getter.All(settings)
-	valueOpts := &values.Options{}
-	vals, err := valueOpts.MergeValues(p)
+	rels, err := client.Run()
 	if err != nil {
-		log.Fatal(err)
+		return nil, fmt.Errorf("listing releases: %w", err)
 	}
 
-	// Add args
-	if err := strvals.ParseInto(args["set"], vals); err != nil {
-		log.Fatal(errors.Wrap(err, "failed parsing --set data"))
+	for _, rel := range rels {
+		rs = append(rs, newRelease(rel))
 	}
 
-	// Check chart dependencies to make sure all are present in /charts
-	chartRequested, err := loader.Load(cp)
+	return
+}
+
+// load locates and loads chart, and merges values from files and set entries
+func (c *Client) load(cpo *action.ChartPathOptions, name string, o Options) (ch *chart.Chart, vals map[string]interface{}, err error) {
+	cp, err := cpo.LocateChart(name, c.settings)
 	if err != nil {
-		log.Fatal(err)
+		return nil, nil, fmt.Errorf("locating chart %s: %w", name, err)
 	}
 
-	validInstallableChart, err := isChartInstallable(chartRequested)
-	if !validInstallableChart {
-		log.Fatal(err)
+	valueOpts := &values.Options{
+		ValueFiles: o.ValuesFiles,
+		Values:     o.Set,
+	}
+	vals, err = valueOpts.MergeValues(getter.All(c.settings))
+	if err != nil {
+		return nil, nil, fmt.Errorf("merging values: %w", err)
 	}
+	vals = mergeValues(o.Values, vals)
 
-	_, err = client.Run(release, chartRequested, vals)
+	ch, err = loader.Load(cp)
 	if err != nil {
-		log.Fatal(err)
+		return nil, nil, fmt.Errorf("loading chart %s: %w", name, err)
+	}
+
+	if err := isChartInstallable(ch); err != nil {
+		return nil, nil, err
+	}
+
+	return
+}
+
+// mergeValues returns copy of base values with overrides merged recursively
+func mergeValues(base, overrides map[string]interface{}) map[string]interface{} {
+	m := make(map[string]interface{}, len(base))
+	for k, v := range base {
+		m[k] = v
+	}
+	for k, v := range overrides {
+		if vm, ok := v.(map[string]interface{}); ok {
+			if bm, ok := m[k].(map[string]interface{}); ok {
+				m[k] = mergeValues(bm, vm)
+				continue
+			}
+		}
+		m[k] = v
+	}
+
+	return m
+}
+
+func newRelease(rel *release.Release) (r Release) {
+	r = Release{
+		Name:      rel.Name,
+		Namespace: rel.Namespace,
+		Revision:  rel.Version,
+	}
+	if rel.Info != nil {
+		r.Status = rel.Info.Status.String()
+		r.Updated = rel.Info.LastDeployed.Time
+	}
+	if rel.Chart != nil && rel.Chart.Metadata != nil {
+		r.Chart = rel.Chart.Metadata.Name + "-" + rel.Chart.Metadata.Version
+		r.Version = rel.Chart.Metadata.Version
+		r.AppVersion = rel.Chart.Metadata.AppVersion
 	}
-	// fmt.Println(rel.Manifest)
 
-	return nil
+	return
 }
 
-func isChartInstallable(ch *chart.Chart) (bool, error) {
+func isChartInstallable(ch *chart.Chart) error {
 	switch ch.Metadata.Type {
 	case "", "application":
-		return true, nil
+		return nil
 	}
-	return false, errors.Errorf("%s charts are not installable", ch.Metadata.Type)
+	return fmt.Errorf("%s charts are not installable", ch.Metadata.Type)
 }
 
 // func debug(format string, v ...interface{}) {
@@ -0,0 +1,198 @@
+package helm
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethersphere/beekeeper/pkg/helm3"
+	"github.com/ethersphere/beekeeper/pkg/k8s"
+)
+
+// compile check whether client implements interface
+var _ k8s.Bee = (*Client)(nil)
+
+// Client manages Bee nodes as releases of the Bee Helm chart, one release per node
+type Client struct {
+	k8s        *k8s.Client
+	kubeconfig string
+	opts       ClientOptions
+
+	mu   sync.Mutex
+	helm map[string]*helm3.Client // Helm clients by namespace
+}
+
+// ClientOptions holds optional parameters for the Client.
+type ClientOptions struct {
+	Chart       string        // chart reference, path or URL
+	Version     string        // chart version, latest if empty
+	ValuesFiles []string      // values files that override values generated from node options
+	Set         []string      // set entries that override values files
+	Timeout     time.Duration // time to wait for Helm operations
+}
+
+// NewClient returns Helm based Kubernetes Bee client
+func NewClient(k8s *k8s.Client, kubeconfig string, o ClientOptions) (c *Client) {
+	return &Client{
+		k8s:        k8s,
+		kubeconfig: kubeconfig,
+		opts:       o,
+		helm:       make(map[string]*helm3.Client),
+	}
+}
+
+// Create creates Bee node in the cluster
+func (c *Client) Create(ctx context.Context, o k8s.CreateOptions) (err error) {
+	h, err := c.helmClient(o.Namespace)
+	if err != nil {
+		return err
+	}
+
+	if _, err := h.Install(o.Name, c.opts.Chart, helm3.Options{
+		Version:     c.opts.Version,
+		Values:      values(o),
+		ValuesFiles: c.opts.ValuesFiles,
+		Set:         c.opts.Set,
+		Timeout:     c.opts.Timeout,
+	}); err != nil {
+		return fmt.Errorf("install release in namespace %s: %w", o.Namespace, err)
+	}
+
+	fmt.Printf("release %s is installed in namespace %s\n", o.Name, o.Namespace)
+	return
+}
+
+// Delete deletes Bee node from the cluster
+func (c *Client) Delete(ctx context.Context, name, namespace string) (err error) {
+	h, err := c.helmClient(namespace)
+	if err != nil {
+		return err
+	}
+
+	if err := h.Uninstall(name, c.opts.Timeout); err != nil {
+		return fmt.Errorf("uninstall release in namespace %s: %w", namespace, err)
+	}
+
+	fmt.Printf("node %s is deleted in namespace %s\n", name, namespace)
+	return
+}
+
+// Ready gets Bee node's readiness
+func (c *Client) Ready(ctx context.Context, name, namespace string) (ready bool, err error) {
+	r, err := c.k8s.StatefulSet.ReadyReplicas(ctx, name, namespace)
+	if err != nil {
+		return false, fmt.Errorf("statefulset %s in namespace %s ready replicas: %w", name, namespace, err)
+	}
+
+	return r == 1, nil
+}
+
+// RunningNodes returns list of running nodes
+func (c *Client) RunningNodes(ctx context.Context, namespace string) (running []string, err error) {
+	statefulSets, err := c.k8s.StatefulSet.RunningStatefulSets(ctx, namespace)
+	if err != nil {
+		return nil, fmt.Errorf("running statefulsets in namespace %s: %w", namespace, err)
+	}
+
+	return c.releases(namespace, statefulSets)
+}
+
+// Start starts Bee node in the cluster
+func (c *Client) Start(ctx context.Context, name, namespace string) (err error) {
+	if err := c.scale(name, namespace, 1); err != nil {
+		return err
+	}
+
+	fmt.Printf("node %s is started in namespace %s\n", name, namespace)
+	return
+}
+
+// Stop stops Bee node in the cluster
+func (c *Client) Stop(ctx context.Context, name, namespace string) (err error) {
+	if err := c.scale(name, namespace, 0); err != nil {
+		return err
+	}
+
+	fmt.Printf("node %s is stopped in namespace %s\n", name, namespace)
+	return
+}
+
+// StoppedNodes returns list of stopped nodes
+func (c *Client) StoppedNodes(ctx context.Context, namespace string) (stopped []string, err error) {
+	statefulSets, err := c.k8s.StatefulSet.StoppedStatefulSets(ctx, namespace)
+	if err != nil {
+		return nil, fmt.Errorf("stopped statefulsets in namespace %s: %w", namespace, err)
+	}
+
+	return c.releases(namespace, statefulSets)
+}
+
+// scale upgrades release with the given replica count, keeping all other
+// values and the deployed chart version
+func (c *Client) scale(name, namespace string, replicas int) (err error) {
+	h, err := c.helmClient(namespace)
+	if err != nil {
+		return err
+	}
+
+	r, err := h.Status(name)
+	if err != nil {
+		return fmt.Errorf("scale release %s in namespace %s: %w", name, namespace, err)
+	}
+
+	if _, err := h.Upgrade(name, c.opts.Chart, helm3.Options{
+		Version:     r.Version,
+		Set:         []string{fmt.Sprintf("replicaCount=%d", replicas)},
+		ReuseValues: true,
+		Timeout:     c.opts.Timeout,
+	}); err != nil {
+		return fmt.Errorf("scale release %s in namespace %s: %w", name, namespace, err)
+	}
+
+	return
+}
+
+// releases filters statefulsets that belong to Helm releases in the namespace
+func (c *Client) releases(namespace string, statefulSets []string) (names []string, err error) {
+	h, err := c.helmClient(namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	rs, err := h.List()
+	if err != nil {
+		return nil, fmt.Errorf("list releases in namespace %s: %w", namespace, err)
+	}
+
+	releases := make(map[string]bool, len(rs))
+	for _, r := range rs {
+		releases[r.Name] = true
+	}
+
+	for _, s := range statefulSets {
+		if releases[s] {
+			names = append(names, s)
+		}
+	}
+
+	return
+}
+
+// helmClient returns Helm client for the namespace, creating it on first use
+func (c *Client) helmClient(namespace string) (h *helm3.Client, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if h, ok := c.helm[namespace]; ok {
+		return h, nil
+	}
+
+	h, err = helm3.NewClient(c.kubeconfig, namespace)
+	if err != nil {
+		return nil, fmt.Errorf("creating helm client for namespace %s: %w", namespace, err)
+	}
+	c.helm[namespace] = h
+
+	return
+}
@@ -0,0 +1,161 @@
+package helm
+
+import (
+	"strings"
+
+	"github.com/ethersphere/beekeeper/pkg/k8s"
+)
+
+// values returns Bee chart values for the node; node is created stopped and
+// fullname is overridden so that chart resources are named after the node
+func values(o k8s.CreateOptions) map[string]interface{} {
+	repository, tag := splitImage(o.Image)
+	clefRepository, clefTag := splitImage(o.ClefImage)
+
+	imagePullSecrets := []interface{}{}
+	for _, s := range o.ImagePullSecrets {
+		imagePullSecrets = append(imagePullSecrets, map[string]interface{}{"name": s})
+	}
+
+	return map[string]interface{}{
+		"fullnameOverride": o.Name,
+		"replicaCount":     0,
+		"image": map[string]interface{}{
+			"repository": repository,
+			"tag":        tag,
+			"pullPolicy": o.ImagePullPolicy,
+		},
+		"imagePullSecrets":    imagePullSecrets,
+		"podAnnotations":      stringMap(o.Annotations),
+		"podLabels":           stringMap(o.Labels),
+		"nodeSelector":        stringMap(o.NodeSelector),
+		"podManagementPolicy": o.PodManagementPolicy,
+		"updateStrategy": map[string]interface{}{
+			"type": o.UpdateStrategy,
+		},
+		"resources": map[string]interface{}{
+			"limits": map[string]interface{}{
+				"cpu":    o.LimitCPU,
+				"memory": o.LimitMemory,
+			},
+			"requests": map[string]interface{}{
+				"cpu":    o.RequestCPU,
+				"memory": o.RequestMemory,
+			},
+		},
+		"persistence": map[string]interface{}{
+			"enabled":      o.PersistenceEnabled,
+			"storageClass": o.PersistenceStorageClass,
+			"size":         o.PersistanceStorageRequest,
+		},
+		"beeConfig": beeConfig(o.Config),
+		"libp2pSettings": map[string]interface{}{
+			"enabled":   len(o.LibP2PKey) > 0,
+			"libp2pKey": o.LibP2PKey,
+		},
+		"swarmSettings": map[string]interface{}{
+			"enabled":  len(o.SwarmKey) > 0,
+			"swarmKey": o.SwarmKey,
+		},
+		"clefSettings": map[string]interface{}{
+			"enabled": len(o.ClefKey) > 0,
+			"image": map[string]interface{}{
+				"repository": clefRepository,
+				"tag":        clefTag,
+				"pullPolicy": o.ClefImagePullPolicy,
+			},
+			"key":      o.ClefKey,
+			"password": o.ClefPassword,
+		},
+		"ingress":      ingress(o.IngressHost, mergeMaps(o.Annotations, o.IngressAnnotations)),
+		"ingressDebug": ingress(o.IngressDebugHost, mergeMaps(o.Annotations, o.IngressDebugAnnotations)),
+	}
+}
+
+// beeConfig returns Bee configuration as chart values, keys are Bee options
+func beeConfig(c k8s.Config) map[string]interface{} {
+	return map[string]interface{}{
+		"api-addr":              c.APIAddr,
+		"bootnode":              c.Bootnodes,
+		"clef-signer-enable":    c.ClefSignerEnable,
+		"clef-signer-endpoint":  c.ClefSignerEndpoint,
+		"cors-allowed-origins":  c.CORSAllowedOrigins,
+		"data-dir":              c.DataDir,
+		"db-capacity":           c.DBCapacity,
+		"debug-api-addr":        c.DebugAPIAddr,
+		"debug-api-enable":      c.DebugAPIEnable,
+		"full-node":             c.FullNode,
+		"gateway-mode":          c.GatewayMode,
+		"global-pinning-enable": c.GlobalPinningEnabled,
+		"nat-addr":              c.NATAddr,
+		"network-id":            c.NetworkID,
+		"p2p-addr":              c.P2PAddr,
+		"p2p-quic-enable":       c.P2PQUICEnable,
+		"p2p-ws-enable":         c.P2PWSEnable,
+		"password":              c.Password,
+		"payment-early":         c.PaymentEarly,
+		"payment-threshold":     c.PaymentThreshold,
+		"payment-tolerance":     c.PaymentTolerance,
+		"postage-stamp-address": c.PostageStampAddress,
+		"price-oracle-address":  c.PriceOracleAddress,
+		"resolver-options":      c.ResolverOptions,
+		"standalone":            c.Standalone,
+		"swap-enable":           c.SwapEnable,
+		"swap-endpoint":         c.SwapEndpoint,
+		"swap-factory-address":  c.SwapFactoryAddress,
+		"swap-initial-deposit":  c.SwapInitialDeposit,
+		"tracing-enable":        c.TracingEnabled,
+		"tracing-endpoint":      c.TracingEndpoint,
+		"tracing-service-name":  c.TracingServiceName,
+		"verbosity":             c.Verbosity,
+		"welcome-message":       c.WelcomeMessage,
+	}
+}
+
+func ingress(host string, annotations map[string]string) map[string]interface{} {
+	if host == "" {
+		return map[string]interface{}{"enabled": false}
+	}
+
+	return map[string]interface{}{
+		"enabled":     true,
+		"annotations": stringMap(annotations),
+		"hosts": []interface{}{
+			map[string]interface{}{
+				"host":  host,
+				"paths": []interface{}{"/"},
+			},
+		},
+	}
+}
+
+// splitImage splits image reference into repository and tag
+func splitImage(image string) (repository, tag string) {
+	i := strings.LastIndex(image, ":")
+	if i < 0 || strings.Contains(image[i:], "/") {
+		return image, "latest"
+	}
+
+	return image[:i], image[i+1:]
+}
+
+func stringMap(m map[string]string) map[string]interface{} {
+	v := make(map[string]interface{}, len(m))
+	for k, s := range m {
+		v[k] = s
+	}
+
+	return v
+}
+
+func mergeMaps(a, b map[string]string) map[string]string {
+	m := map[string]string{}
+	for k, v := range a {
+		m[k] = v
+	}
+	for k, v := range b {
+		m[k] = v
+	}
+
+	return m
+}
@@ -2,7 +2,6 @@ package k8s
 
 import (
 	"errors"
-	"flag"
 	"fmt"
 	"os"
 
@@ -84,10 +83,7 @@ func NewClient(o *ClientOptions) (c *Client, err error) {
 		configPath = o.KubeconfigPath
 	}
 
-	kubeconfig := flag.String("kubeconfig", configPath, "kubeconfig file")
-	flag.Parse()
-
-	config, err := clientcmd.BuildConfigFromFlags("", *kubeconfig)
+	config, err := clientcmd.BuildConfigFromFlags("", configPath)
 	if err != nil {
 		return nil, fmt.Errorf("creating Kubernetes client config: %w", err)
 	}
@@ -68,3 +68,20 @@ func (c *Client) Delete(ctx context.Context, name, namespace string) (err error)
 
 	return
 }
+
+// List returns names of Pods that match the label selector
+func (c *Client) List(ctx context.Context, namespace, labelSelector string) (names []string, err error) {
+	pods, err := c.clientset.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{LabelSelector: labelSelector})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("list pods in namespace %s: %w", namespace, err)
+	}
+
+	for _, p := range pods.Items {
+		names = append(names, p.Name)
+	}
+
+	return
+}
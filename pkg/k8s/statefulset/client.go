@@ -43,6 +43,23 @@ func (c *Client) Delete(ctx context.Context, name, namespace string) (err error)
 	return
 }
 
+// List returns names of StatefulSets that match the label selector
+func (c *Client) List(ctx context.Context, namespace, labelSelector string) (names []string, err error) {
+	statefulSets, err := c.clientset.AppsV1().StatefulSets(namespace).List(ctx, metav1.ListOptions{LabelSelector: labelSelector})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("list statefulsets in namespace %s: %w", namespace, err)
+	}
+
+	for _, s := range statefulSets.Items {
+		names = append(names, s.Name)
+	}
+
+	return
+}
+
 // ReadyReplicas returns number of Pods created by the StatefulSet controller that have a Ready Condition
 func (c *Client) ReadyReplicas(ctx context.Context, name, namespace string) (ready int32, err error) {
 	s, err := c.clientset.AppsV1().StatefulSets(namespace).Get(ctx, name, metav1.GetOptions{})
@@ -57,6 +74,19 @@ func (c *Client) ReadyReplicas(ctx context.Context, name, namespace string) (rea
 	return
 }
 
+// Replicas returns desired number of replicas of the StatefulSet
+func (c *Client) Replicas(ctx context.Context, name, namespace string) (replicas int32, err error) {
+	s, err := c.clientset.AppsV1().StatefulSets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return 0, fmt.Errorf("getting replicas from statefulset %s in namespace %s: %w", name, namespace, err)
+	}
+	if s.Spec.Replicas != nil {
+		replicas = *s.Spec.Replicas
+	}
+
+	return
+}
+
 // RunningStatefulSets returns names of running StatefulSets
 func (c *Client) RunningStatefulSets(ctx context.Context, namespace string) (running []string, err error) {
 	statefulSets, err := c.clientset.AppsV1().StatefulSets(namespace).List(ctx, metav1.ListOptions{})
@@ -0,0 +1,68 @@
+package ledger
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Durability records retrievability of ledger entries by their age at the
+// time of verification. It is safe for concurrent use.
+type Durability struct {
+	mu          sync.Mutex
+	buckets     []time.Duration // upper bounds of age buckets, ascending
+	checked     []int
+	retrievable []int
+}
+
+// NewDurability returns new durability record with given upper bounds of age
+// buckets; ages above the last bound fall into an additional bucket
+func NewDurability(buckets []time.Duration) *Durability {
+	return &Durability{
+		buckets:     buckets,
+		checked:     make([]int, len(buckets)+1),
+		retrievable: make([]int, len(buckets)+1),
+	}
+}
+
+// Record records whether content of the given age is retrievable
+func (d *Durability) Record(age time.Duration, retrievable bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	i := 0
+	for i < len(d.buckets) && age >= d.buckets[i] {
+		i++
+	}
+
+	d.checked[i]++
+	if retrievable {
+		d.retrievable[i]++
+	}
+}
+
+// Report prints percentage of retrievable content by age bucket
+func (d *Durability) Report() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for i := range d.checked {
+		if d.checked[i] == 0 {
+			continue
+		}
+
+		var name string
+		switch {
+		case len(d.buckets) == 0:
+			name = "any age"
+		case i == 0:
+			name = fmt.Sprintf("age < %s", d.buckets[0])
+		case i == len(d.buckets):
+			name = fmt.Sprintf("age >= %s", d.buckets[i-1])
+		default:
+			name = fmt.Sprintf("%s <= age < %s", d.buckets[i-1], d.buckets[i])
+		}
+
+		fmt.Printf("durability %s: %d/%d retrievable (%.2f%%)\n", name, d.retrievable[i], d.checked[i], 100*float64(d.retrievable[i])/float64(d.checked[i]))
+	}
+}
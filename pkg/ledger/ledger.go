@@ -0,0 +1,184 @@
+// Package ledger records uploaded content, so that its retrievability can be
+// verified later, possibly by another beekeeper process days after the upload.
+package ledger
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/ethersphere/bee/pkg/swarm"
+)
+
+// Kinds of uploaded content, they determine how the content is downloaded
+const (
+	KindBytes      = "bytes"
+	KindChunk      = "chunk"
+	KindFile       = "file"
+	KindCollection = "collection" // file at Path in an uploaded collection
+)
+
+// Entry represents uploaded content recorded in the ledger
+type Entry struct {
+	Reference swarm.Address `json:"reference"`
+	Kind      string        `json:"kind"`
+	Path      string        `json:"path,omitempty"` // collection file path
+	Size      int64         `json:"size"`
+	Hash      []byte        `json:"hash"`
+	Node      string        `json:"node"` // uploader node
+	BatchID   string        `json:"batchID,omitempty"`
+	Seed      int64         `json:"seed,omitempty"`
+	Source    string        `json:"source,omitempty"` // command that uploaded the content
+	Uploaded  time.Time     `json:"uploaded"`
+}
+
+// Ledger records every uploaded reference with its hash. Ledger opened from a
+// file appends every entry to the file as a JSON line, so that entries
+// survive the process. It is safe for concurrent use.
+type Ledger struct {
+	mu      sync.Mutex
+	entries []Entry
+	file    *os.File
+	source  string
+	seed    int64
+}
+
+// New returns new in-memory ledger
+func New() *Ledger {
+	return &Ledger{}
+}
+
+// Open opens ledger stored in a JSON lines file, creating the file if it does
+// not exist. Existing entries are loaded and new entries are appended.
+func Open(path string) (*Ledger, error) {
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("open ledger: %w", err)
+	}
+
+	l := &Ledger{file: f}
+	s := bufio.NewScanner(f)
+	s.Buffer(make([]byte, 64*1024), 1024*1024)
+	for line := 1; s.Scan(); line++ {
+		if len(s.Bytes()) == 0 {
+			continue
+		}
+
+		var e Entry
+		if err := json.Unmarshal(s.Bytes(), &e); err != nil {
+			f.Close()
+			return nil, fmt.Errorf("ledger %s line %d: %w", path, line, err)
+		}
+		l.entries = append(l.entries, e)
+	}
+	if err := s.Err(); err != nil {
+		f.Close()
+		return nil, fmt.Errorf("read ledger %s: %w", path, err)
+	}
+
+	return l, nil
+}
+
+// Close closes the ledger file
+func (l *Ledger) Close() error {
+	if l == nil || l.file == nil {
+		return nil
+	}
+
+	return l.file.Close()
+}
+
+// SetSource sets source of subsequently added entries
+func (l *Ledger) SetSource(source string) {
+	if l == nil {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.source = source
+}
+
+// SetSeed sets seed of subsequently added entries
+func (l *Ledger) SetSeed(seed int64) {
+	if l == nil {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.seed = seed
+}
+
+// Add adds entry to the ledger. Source and seed of the entry are set from the
+// ledger if they are not set. Adding to a nil ledger is a no-op.
+func (l *Ledger) Add(e Entry) error {
+	if l == nil {
+		return nil
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if e.Source == "" {
+		e.Source = l.source
+	}
+	if e.Seed == 0 {
+		e.Seed = l.seed
+	}
+	if e.Uploaded.IsZero() {
+		e.Uploaded = time.Now()
+	}
+
+	if l.file != nil {
+		b, err := json.Marshal(e)
+		if err != nil {
+			return fmt.Errorf("ledger entry: %w", err)
+		}
+		if _, err := l.file.Write(append(b, '\n')); err != nil {
+			return fmt.Errorf("write ledger entry: %w", err)
+		}
+	}
+
+	l.entries = append(l.entries, e)
+	return nil
+}
+
+// Entries returns all entries in the ledger
+func (l *Ledger) Entries() []Entry {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return append([]Entry(nil), l.entries...)
+}
+
+// Len returns number of entries in the ledger
+func (l *Ledger) Len() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return len(l.entries)
+}
+
+// Sample returns n randomly chosen entries, or all entries if there are less
+// than n of them
+func (l *Ledger) Sample(rnd *rand.Rand, n int) []Entry {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if n >= len(l.entries) {
+		return append([]Entry(nil), l.entries...)
+	}
+
+	sample := make([]Entry, n)
+	for i, j := range rnd.Perm(len(l.entries))[:n] {
+		sample[i] = l.entries[j]
+	}
+	return sample
+}
@@ -0,0 +1,107 @@
+package stress
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/ethersphere/bee/pkg/swarm"
+	"github.com/ethersphere/beekeeper/pkg/bee"
+	"github.com/ethersphere/beekeeper/pkg/beeclient/api"
+)
+
+// SeedCorpus uploads corpus of CorpusSize files of FileSize to random nodes
+// and returns uploaded files
+func SeedCorpus(ctx context.Context, clients map[string]*bee.Client, nodeNames []string, rnd *rand.Rand, o Options) ([]bee.File, error) {
+	dataset, err := NewDataset(ctx, clients, nodeNames, rnd.Int63(), o)
+	if err != nil {
+		return nil, err
+	}
+
+	corpus := make([]bee.File, o.CorpusSize)
+	for i := range corpus {
+		p := nodeNames[rnd.Intn(len(nodeNames))]
+		n := clients[p]
+		file, err := dataset.File(fmt.Sprintf("corpus-%d", i), o.FileSize)
+		if err != nil {
+			return nil, fmt.Errorf("corpus file %d: %w", i, err)
+		}
+
+		batchID, err := n.Batches().Get(ctx, bee.BatchOptions{
+			Amount: o.PostageAmount,
+			Depth:  16,
+			Label:  "test-label",
+			Chunks: bee.CalculateNumberOfChunks(file.Size(), false),
+			Prefix: dataset.Prefix(),
+			Wait:   o.PostageWait,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("node %s: batch id %w", p, err)
+		}
+
+		for retryCount := 0; ; retryCount++ {
+			err := n.UploadFile(ctx, &file, api.UploadOptions{BatchID: batchID})
+			if err == nil {
+				break
+			}
+			if retryCount >= o.Retries {
+				return nil, fmt.Errorf("corpus file %d upload to node %s: %w", i, p, err)
+			}
+			fmt.Printf("error: uploading corpus file %d to node %s: %v\n", i, p, err)
+
+			time.Sleep(o.RetryDelay)
+			// start from the beginning of the file data if the previous attempt failed
+			if err := file.Rewind(); err != nil {
+				return nil, fmt.Errorf("node %s: %w", p, err)
+			}
+		}
+
+		corpus[i] = file
+		fmt.Printf("corpus file %d %s uploaded to node %s\n", i, file.Address(), p)
+	}
+
+	return corpus, nil
+}
+
+// NewDataset returns generator of stress files whose chunks follow the
+// configured distribution relative to overlays of nodes in the given order
+func NewDataset(ctx context.Context, clients map[string]*bee.Client, nodeNames []string, seed int64, o Options) (*bee.DatasetGenerator, error) {
+	do, err := DatasetOptions(ctx, clients, nodeNames, seed, o)
+	if err != nil {
+		return nil, err
+	}
+
+	g, err := bee.NewDatasetGenerator(do)
+	if err != nil {
+		return nil, fmt.Errorf("dataset generator: %w", err)
+	}
+
+	return g, nil
+}
+
+// DatasetOptions returns options of the generator of stress files, so that
+// generators with different seeds can be created without querying nodes
+func DatasetOptions(ctx context.Context, clients map[string]*bee.Client, nodeNames []string, seed int64, o Options) (bee.DatasetOptions, error) {
+	var targets []swarm.Address
+	if o.Distribution != bee.DistributionUniform {
+		if o.FileSize > bee.MaxMinedFileSize {
+			return bee.DatasetOptions{}, fmt.Errorf("%s distribution file size %d exceeds %d", o.Distribution, o.FileSize, bee.MaxMinedFileSize)
+		}
+		for _, name := range nodeNames {
+			overlay, err := clients[name].Overlay(ctx)
+			if err != nil {
+				return bee.DatasetOptions{}, fmt.Errorf("node %s: %w", name, err)
+			}
+			targets = append(targets, overlay)
+		}
+	}
+
+	return bee.DatasetOptions{
+		Seed:         seed,
+		Distribution: o.Distribution,
+		Targets:      targets,
+		Depth:        o.DistributionDepth,
+		PayloadSize:  swarm.ChunkSize,
+	}, nil
+}
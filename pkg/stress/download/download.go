@@ -0,0 +1,193 @@
+package download
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethersphere/beekeeper/pkg/bee"
+	"github.com/ethersphere/beekeeper/pkg/random"
+	"github.com/ethersphere/beekeeper/pkg/stress"
+	"github.com/prometheus/common/expfmt"
+	"golang.org/x/sync/errgroup"
+)
+
+// compile stress whether Download implements interface
+var _ stress.Stress = (*Download)(nil)
+
+// Popularity distributions of corpus files
+const (
+	PopularityUniform = "uniform"
+	PopularityZipf    = "zipf"
+)
+
+var errDownloadHash = errors.New("downloaded file hash mismatch")
+
+// Download stress
+type Download struct {
+	metricsOnce sync.Once
+}
+
+// NewDownload returns new download stress
+func NewDownload() *Download {
+	return &Download{}
+}
+
+// Run executes download stress
+func (d *Download) Run(ctx context.Context, cluster *bee.Cluster, o stress.Options) (err error) {
+	concurrency := 100
+
+	if o.CorpusSize <= 0 {
+		return fmt.Errorf("corpus size must be greater than 0")
+	}
+	if o.Popularity != PopularityUniform && o.Popularity != PopularityZipf {
+		return fmt.Errorf("unknown popularity distribution %q", o.Popularity)
+	}
+	if o.Popularity == PopularityZipf && o.ZipfS <= 1 {
+		return fmt.Errorf("zipf exponent must be greater than 1")
+	}
+
+	if o.MetricsEnabled {
+		d.metricsOnce.Do(func() {
+			o.MetricsPusher.Collector(downloadDuration)
+			o.MetricsPusher.Collector(downloadedBytes)
+			o.MetricsPusher.Collector(downloadErrors)
+			o.MetricsPusher.Format(expfmt.FmtText)
+		})
+	}
+
+	clients, err := cluster.NodesClients(ctx)
+	if err != nil {
+		return fmt.Errorf("node clients: %w", err)
+	}
+
+	nodeNames := []string{}
+	for k := range clients {
+		nodeNames = append(nodeNames, k)
+	}
+	sort.Strings(nodeNames)
+	rnd := random.PseudoGenerator(o.Seed)
+
+	corpus, err := stress.SeedCorpus(ctx, clients, nodeNames, rnd, o)
+	if err != nil {
+		return err
+	}
+
+	nodeCount := int(math.Round(float64(len(clients)*o.DownloadNodesPercentage) / 100))
+	picked := randomPick(rnd, nodeNames, nodeCount)
+	rnds := random.PseudoGenerators(rnd.Int63(), nodeCount)
+
+	dGroup := new(errgroup.Group)
+	dSemaphore := make(chan struct{}, concurrency)
+	for i, p := range picked {
+		i := i
+		p := p
+		n := clients[p]
+
+		dSemaphore <- struct{}{}
+		dGroup.Go(func() error {
+			defer func() {
+				<-dSemaphore
+			}()
+
+			ctx, ctxCancel := context.WithTimeout(ctx, o.Timeout)
+			defer ctxCancel()
+
+			next := popularity(rnds[i], len(corpus), o)
+			for {
+				file := corpus[next()]
+
+				retryCount := 0
+				for {
+					if ctx.Err() != nil {
+						if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+							return nil
+						}
+						return ctx.Err()
+					}
+
+					t := time.Now()
+					size, hash, err := n.DownloadFile(ctx, file.Address())
+					if err != nil {
+						if ctx.Err() != nil {
+							continue
+						}
+
+						o.Recorder.Fail(stress.OperationDownload, p)
+						if o.MetricsEnabled {
+							downloadErrors.WithLabelValues(p).Inc()
+						}
+
+						retryCount++
+						if retryCount > o.Retries {
+							return fmt.Errorf("file %s download from node %s exceeded number of retries: %w", file.Address(), p, err)
+						}
+						fmt.Printf("error: downloading file %s from node %s: %v\n", file.Address(), p, err)
+
+						select {
+						case <-time.After(o.RetryDelay):
+						case <-ctx.Done():
+						}
+						continue
+					}
+					took := time.Since(t)
+
+					if !bytes.Equal(file.Hash(), hash) {
+						return fmt.Errorf("node %s: file %s: %w: downloaded %d bytes; uploaded %d bytes", p, file.Address(), errDownloadHash, size, file.Size())
+					}
+
+					o.Recorder.Record(stress.OperationDownload, p, took, size)
+					if o.MetricsEnabled {
+						downloadDuration.WithLabelValues(p).Observe(took.Seconds())
+						downloadedBytes.WithLabelValues(p).Add(float64(size))
+					}
+					break
+				}
+			}
+		})
+	}
+
+	err = dGroup.Wait()
+
+	if o.MetricsEnabled {
+		if err := o.MetricsPusher.Push(); err != nil {
+			fmt.Printf("push metrics: %v\n", err)
+		}
+	}
+
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("download stress completed successfully")
+	return
+}
+
+// popularity returns function that draws indexes of corpus files according to
+// the popularity distribution; with Zipf distribution the first file is the
+// most popular
+func popularity(rnd *rand.Rand, size int, o stress.Options) func() int {
+	if o.Popularity == PopularityZipf {
+		z := rand.NewZipf(rnd, o.ZipfS, 1, uint64(size-1))
+		return func() int { return int(z.Uint64()) }
+	}
+
+	return func() int { return rnd.Intn(size) }
+}
+
+// randomPick randomly picks n elements from the list, and returns lists of picked elements
+func randomPick(rnd *rand.Rand, list []string, n int) (picked []string) {
+	list = append([]string(nil), list...)
+	for i := 0; i < n; i++ {
+		index := rnd.Intn(len(list))
+		picked = append(picked, list[index])
+		list = append(list[:index], list[index+1:]...)
+	}
+	return
+}
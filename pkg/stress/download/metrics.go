@@ -0,0 +1,34 @@
+package download
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var (
+	downloadDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "beekeeper",
+			Subsystem: "stress_download",
+			Name:      "duration_seconds",
+			Help:      "File download duration Histogram",
+			Buckets:   prometheus.ExponentialBuckets(0.01, 2, 14),
+		},
+		[]string{"node"},
+	)
+	downloadedBytes = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "beekeeper",
+			Subsystem: "stress_download",
+			Name:      "bytes_total",
+			Help:      "Total number of downloaded bytes",
+		},
+		[]string{"node"},
+	)
+	downloadErrors = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "beekeeper",
+			Subsystem: "stress_download",
+			Name:      "errors_total",
+			Help:      "Total number of failed downloads",
+		},
+		[]string{"node"},
+	)
+)
@@ -0,0 +1,177 @@
+package stress
+
+import (
+	"math/bits"
+	"sync"
+	"time"
+)
+
+// histogramPrecision is the number of bits of sub-buckets per power of two,
+// it bounds the relative error of recorded values to 2^-histogramPrecision
+const histogramPrecision = 7
+
+// Histogram is a high dynamic range histogram of durations. Values are
+// recorded in microseconds into buckets whose width grows with the value, so
+// that the relative error is constant, less than 1%, from microseconds to
+// hours, with constant memory. It is safe for concurrent use.
+type Histogram struct {
+	mu     sync.Mutex
+	counts []int64
+	count  int64
+	sum    time.Duration
+	min    time.Duration
+	max    time.Duration
+}
+
+// NewHistogram returns new empty histogram
+func NewHistogram() *Histogram {
+	return &Histogram{}
+}
+
+// Record records duration
+func (h *Histogram) Record(d time.Duration) {
+	if d < 0 {
+		d = 0
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	i := bucketIndex(uint64(d / time.Microsecond))
+	if i >= len(h.counts) {
+		counts := make([]int64, i+1)
+		copy(counts, h.counts)
+		h.counts = counts
+	}
+	h.counts[i]++
+
+	if h.count == 0 || d < h.min {
+		h.min = d
+	}
+	if d > h.max {
+		h.max = d
+	}
+	h.count++
+	h.sum += d
+}
+
+// Merge adds all values recorded in the other histogram to the histogram
+func (h *Histogram) Merge(other *Histogram) {
+	other.mu.Lock()
+	counts := append([]int64(nil), other.counts...)
+	count, sum, min, max := other.count, other.sum, other.min, other.max
+	other.mu.Unlock()
+
+	if count == 0 {
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if len(counts) > len(h.counts) {
+		c := make([]int64, len(counts))
+		copy(c, h.counts)
+		h.counts = c
+	}
+	for i, c := range counts {
+		h.counts[i] += c
+	}
+
+	if h.count == 0 || min < h.min {
+		h.min = min
+	}
+	if max > h.max {
+		h.max = max
+	}
+	h.count += count
+	h.sum += sum
+}
+
+// Count returns number of recorded values
+func (h *Histogram) Count() int64 {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return h.count
+}
+
+// Min returns the smallest recorded value
+func (h *Histogram) Min() time.Duration {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return h.min
+}
+
+// Max returns the largest recorded value
+func (h *Histogram) Max() time.Duration {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return h.max
+}
+
+// Mean returns mean of recorded values
+func (h *Histogram) Mean() time.Duration {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.count == 0 {
+		return 0
+	}
+	return h.sum / time.Duration(h.count)
+}
+
+// Percentile returns value below or at which p percent of recorded values
+// are; it is the highest value of the bucket, but never larger than the max
+func (h *Histogram) Percentile(p float64) time.Duration {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.count == 0 {
+		return 0
+	}
+
+	rank := int64(p / 100 * float64(h.count))
+	if float64(rank) < p/100*float64(h.count) || rank == 0 {
+		rank++
+	}
+
+	var seen int64
+	for i, c := range h.counts {
+		seen += c
+		if seen >= rank {
+			v := time.Duration(bucketHighest(i)) * time.Microsecond
+			if v > h.max {
+				v = h.max
+			}
+			return v
+		}
+	}
+
+	return h.max
+}
+
+// bucketIndex returns index of the bucket of the value. Values smaller than
+// 2^(precision+1) have their own buckets, larger values share a bucket with
+// values that differ only in bits below the precision.
+func bucketIndex(v uint64) int {
+	if v < 1<<(histogramPrecision+1) {
+		return int(v)
+	}
+
+	shift := bits.Len64(v) - 1 - histogramPrecision
+	return shift<<histogramPrecision + int(v>>shift)
+}
+
+// bucketHighest returns the highest value of the bucket with index i
+func bucketHighest(i int) uint64 {
+	if i < 1<<(histogramPrecision+1) {
+		return uint64(i)
+	}
+
+	shift := i>>histogramPrecision - 1
+	m := uint64(i - shift<<histogramPrecision)
+	return (m+1)<<shift - 1
+}
@@ -0,0 +1,286 @@
+package load
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethersphere/beekeeper/pkg/bee"
+	"github.com/ethersphere/beekeeper/pkg/beeclient/api"
+	"github.com/ethersphere/beekeeper/pkg/random"
+	"github.com/ethersphere/beekeeper/pkg/stress"
+	"github.com/prometheus/common/expfmt"
+	"golang.org/x/sync/errgroup"
+)
+
+// compile stress whether Load implements interface
+var _ stress.Stress = (*Load)(nil)
+
+var errDownloadHash = errors.New("downloaded file hash mismatch")
+
+// Load stress
+type Load struct {
+	metricsOnce sync.Once
+}
+
+// NewLoad returns new load stress
+func NewLoad() *Load {
+	return &Load{}
+}
+
+// Run executes open-loop load stress. Requests arrive at the rate given by
+// the profile regardless of how long previous requests take, and latency is
+// measured from the intended start of the request, so that waiting for a free
+// in-flight slot is included in the latency.
+func (l *Load) Run(ctx context.Context, cluster *bee.Cluster, o stress.Options) (err error) {
+	profile := stress.Profile{
+		Rate:     o.Rate,
+		RampUp:   o.RampUp,
+		Plateau:  o.Plateau,
+		RampDown: o.RampDown,
+	}
+	if profile.Rate <= 0 {
+		return fmt.Errorf("rate must be greater than 0")
+	}
+	if profile.Duration() <= 0 {
+		return fmt.Errorf("load duration must be greater than 0")
+	}
+	if o.ReadRatio < 0 || o.ReadRatio > 1 {
+		return fmt.Errorf("read ratio must be between 0 and 1")
+	}
+	if o.ReadRatio > 0 && o.CorpusSize <= 0 {
+		return fmt.Errorf("corpus size must be greater than 0 for reads")
+	}
+	if o.MaxInFlight <= 0 {
+		return fmt.Errorf("maximum number of in-flight requests must be greater than 0")
+	}
+
+	if o.MetricsEnabled {
+		l.metricsOnce.Do(func() {
+			o.MetricsPusher.Collector(requestDuration)
+			o.MetricsPusher.Collector(requestErrors)
+			o.MetricsPusher.Format(expfmt.FmtText)
+		})
+	}
+
+	clients, err := cluster.NodesClients(ctx)
+	if err != nil {
+		return fmt.Errorf("node clients: %w", err)
+	}
+
+	nodeNames := []string{}
+	for k := range clients {
+		nodeNames = append(nodeNames, k)
+	}
+	sort.Strings(nodeNames)
+	rnd := random.PseudoGenerator(o.Seed)
+
+	stored := &corpus{}
+	if o.ReadRatio > 0 {
+		files, err := stress.SeedCorpus(ctx, clients, nodeNames, rnd, o)
+		if err != nil {
+			return err
+		}
+		stored.add(files...)
+	}
+
+	do, err := stress.DatasetOptions(ctx, clients, nodeNames, 0, o)
+	if err != nil {
+		return err
+	}
+	dataset, err := bee.NewDatasetGenerator(do)
+	if err != nil {
+		return fmt.Errorf("dataset generator: %w", err)
+	}
+
+	// decide parameters of all requests upfront, so that the sequence of
+	// requests does not depend on timing and writes to each node are known
+	var requests []request
+	writes := make(map[string]int64)
+	for n := 0; ; n++ {
+		arrival, ok := profile.Arrival(n)
+		if !ok {
+			break
+		}
+		r := request{
+			arrival: arrival,
+			node:    nodeNames[rnd.Intn(len(nodeNames))],
+			read:    rnd.Float64() < o.ReadRatio,
+			seed:    rnd.Int63(),
+		}
+		if !r.read {
+			writes[r.node]++
+		}
+		requests = append(requests, r)
+	}
+
+	// buy batches upfront, so that batch creation is not measured; batches are
+	// deep enough for all chunks written to the node
+	batches := make(map[string]string)
+	for _, p := range nodeNames {
+		if writes[p] == 0 {
+			continue
+		}
+		batchID, err := clients[p].Batches().Get(ctx, bee.BatchOptions{
+			Amount: o.PostageAmount,
+			Label:  "test-label",
+			Chunks: writes[p] * bee.CalculateNumberOfChunks(o.FileSize, false),
+			Prefix: dataset.Prefix(),
+			Wait:   o.PostageWait,
+		})
+		if err != nil {
+			return fmt.Errorf("node %s: batch id %w", p, err)
+		}
+		batches[p] = batchID
+	}
+
+	fmt.Printf("load: %d requests at %.2f requests/s with ramp-up %s, plateau %s, ramp-down %s, read ratio %.2f\n", profile.Requests(), profile.Rate, profile.RampUp, profile.Plateau, profile.RampDown, o.ReadRatio)
+
+	lGroup, lCtx := errgroup.WithContext(ctx)
+	inFlight := make(chan struct{}, o.MaxInFlight)
+	start := time.Now()
+
+SCHEDULE:
+	for _, r := range requests {
+		r := r
+		intended := start.Add(r.arrival)
+
+		select {
+		case <-time.After(time.Until(intended)):
+		case <-lCtx.Done():
+			break SCHEDULE
+		}
+
+		select {
+		case inFlight <- struct{}{}:
+		case <-lCtx.Done():
+			break SCHEDULE
+		}
+
+		lGroup.Go(func() error {
+			defer func() {
+				<-inFlight
+			}()
+
+			if r.read {
+				return download(lCtx, clients[r.node], r.node, stored, r.seed, intended, o)
+			}
+			return upload(lCtx, clients[r.node], r.node, batches[r.node], stored, do, r.seed, intended, o)
+		})
+	}
+
+	err = lGroup.Wait()
+
+	if o.MetricsEnabled {
+		if err := o.MetricsPusher.Push(); err != nil {
+			fmt.Printf("push metrics: %v\n", err)
+		}
+	}
+
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("load stress completed successfully")
+	return
+}
+
+// download downloads random corpus file from the node and verifies its hash;
+// failed downloads are recorded, but they do not stop the load
+func download(ctx context.Context, c *bee.Client, node string, corpus *corpus, seed int64, intended time.Time, o stress.Options) error {
+	file := corpus.random(rand.New(rand.NewSource(seed)))
+
+	size, hash, err := c.DownloadFile(ctx, file.Address())
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil
+		}
+		o.Recorder.Fail(stress.OperationDownload, node)
+		if o.MetricsEnabled {
+			requestErrors.WithLabelValues(stress.OperationDownload).Inc()
+		}
+		fmt.Printf("error: downloading file %s from node %s: %v\n", file.Address(), node, err)
+		return nil
+	}
+	took := time.Since(intended)
+
+	if !bytes.Equal(file.Hash(), hash) {
+		return fmt.Errorf("node %s: file %s: %w: downloaded %d bytes; uploaded %d bytes", node, file.Address(), errDownloadHash, size, file.Size())
+	}
+
+	o.Recorder.Record(stress.OperationDownload, node, took, size)
+	if o.MetricsEnabled {
+		requestDuration.WithLabelValues(stress.OperationDownload).Observe(took.Seconds())
+	}
+	return nil
+}
+
+// upload generates new file from the seed, uploads it to the node and adds it
+// to the corpus; failed uploads are recorded, but they do not stop the load.
+// Time spent mining chunks of the file is not included in the latency.
+func upload(ctx context.Context, c *bee.Client, node, batchID string, corpus *corpus, do bee.DatasetOptions, seed int64, intended time.Time, o stress.Options) error {
+	generated := time.Now()
+	do.Seed = seed
+	g, err := bee.NewDatasetGenerator(do)
+	if err != nil {
+		return fmt.Errorf("dataset generator: %w", err)
+	}
+	file, err := g.File("filename", o.FileSize)
+	if err != nil {
+		return fmt.Errorf("generate file: %w", err)
+	}
+	intended = intended.Add(time.Since(generated))
+
+	if err := c.UploadFile(ctx, &file, api.UploadOptions{BatchID: batchID}); err != nil {
+		if ctx.Err() != nil {
+			return nil
+		}
+		o.Recorder.Fail(stress.OperationUpload, node)
+		if o.MetricsEnabled {
+			requestErrors.WithLabelValues(stress.OperationUpload).Inc()
+		}
+		fmt.Printf("error: uploading file to node %s: %v\n", node, err)
+		return nil
+	}
+	took := time.Since(intended)
+
+	corpus.add(file)
+	o.Recorder.Record(stress.OperationUpload, node, took, file.Size())
+	if o.MetricsEnabled {
+		requestDuration.WithLabelValues(stress.OperationUpload).Observe(took.Seconds())
+	}
+	return nil
+}
+
+// request represents scheduled load request
+type request struct {
+	arrival time.Duration // since the start of the load
+	node    string
+	read    bool
+	seed    int64
+}
+
+// corpus represents files that are available for download
+type corpus struct {
+	mu    sync.Mutex
+	files []bee.File
+}
+
+func (c *corpus) add(files ...bee.File) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.files = append(c.files, files...)
+}
+
+func (c *corpus) random(rnd *rand.Rand) bee.File {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.files[rnd.Intn(len(c.files))]
+}
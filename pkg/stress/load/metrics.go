@@ -0,0 +1,25 @@
+package load
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var (
+	requestDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "beekeeper",
+			Subsystem: "stress_load",
+			Name:      "request_duration_seconds",
+			Help:      "Request duration from intended start Histogram",
+			Buckets:   prometheus.ExponentialBuckets(0.01, 2, 14),
+		},
+		[]string{"operation"},
+	)
+	requestErrors = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "beekeeper",
+			Subsystem: "stress_load",
+			Name:      "errors_total",
+			Help:      "Total number of failed requests",
+		},
+		[]string{"operation"},
+	)
+)
@@ -0,0 +1,55 @@
+package stress
+
+import (
+	"math"
+	"time"
+)
+
+// Profile represents open-loop arrival rate profile. Rate increases linearly
+// from zero to the target rate during ramp-up, stays constant during plateau
+// and decreases linearly to zero during ramp-down.
+type Profile struct {
+	Rate     float64 // target number of requests per second
+	RampUp   time.Duration
+	Plateau  time.Duration
+	RampDown time.Duration
+}
+
+// Duration returns total duration of the profile
+func (p Profile) Duration() time.Duration {
+	return p.RampUp + p.Plateau + p.RampDown
+}
+
+// Requests returns total number of requests in the profile
+func (p Profile) Requests() int {
+	return int(p.Rate * (p.RampUp.Seconds()/2 + p.Plateau.Seconds() + p.RampDown.Seconds()/2))
+}
+
+// Arrival returns intended start time of the n-th request, relative to the
+// start of the profile. Arrivals do not depend on how long previous requests
+// take, so that load is not reduced when the cluster slows down.
+func (p Profile) Arrival(n int) (t time.Duration, ok bool) {
+	if p.Rate <= 0 || n >= p.Requests() {
+		return 0, false
+	}
+
+	r := p.Rate
+	up, plateau, down := p.RampUp.Seconds(), p.Plateau.Seconds(), p.RampDown.Seconds()
+	x := float64(n)
+
+	// requests at the end of ramp-up and plateau phases
+	n1 := r * up / 2
+	n2 := n1 + r*plateau
+
+	var s float64
+	switch {
+	case x < n1:
+		s = math.Sqrt(2 * up * x / r)
+	case x < n2:
+		s = up + (x-n1)/r
+	default:
+		s = up + plateau + down - math.Sqrt(math.Max(down*down-2*down*(x-n2)/r, 0))
+	}
+
+	return time.Duration(s * float64(time.Second)), true
+}
@@ -0,0 +1,168 @@
+package stress
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Operations recorded by stress runs, they are used both in SLOs and as
+// metric labels
+const (
+	OperationUpload   = "upload"
+	OperationDownload = "download"
+	OperationSoak     = "soak"
+)
+
+// Recorder records latencies of operations per operation type and node,
+// transferred bytes and failed operations. It is safe for concurrent use.
+type Recorder struct {
+	mu    sync.Mutex
+	stats map[string]map[string]*stats // by operation and node
+	first time.Time
+	last  time.Time
+}
+
+// stats represents statistics of an operation on a node
+type stats struct {
+	latencies *Histogram
+	bytes     int64
+	failed    int64
+}
+
+// NewRecorder returns new recorder
+func NewRecorder() *Recorder {
+	return &Recorder{stats: make(map[string]map[string]*stats)}
+}
+
+// Record records latency and transferred bytes of a successful operation
+func (r *Recorder) Record(operation, node string, d time.Duration, bytes int64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	s := r.get(operation, node)
+	s.latencies.Record(d)
+	s.bytes += bytes
+
+	now := time.Now()
+	if start := now.Add(-d); r.first.IsZero() || start.Before(r.first) {
+		r.first = start
+	}
+	if now.After(r.last) {
+		r.last = now
+	}
+}
+
+// Fail records failed operation
+func (r *Recorder) Fail(operation, node string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.get(operation, node).failed++
+}
+
+func (r *Recorder) get(operation, node string) *stats {
+	nodes, ok := r.stats[operation]
+	if !ok {
+		nodes = make(map[string]*stats)
+		r.stats[operation] = nodes
+	}
+
+	s, ok := nodes[node]
+	if !ok {
+		s = &stats{latencies: NewHistogram()}
+		nodes[node] = s
+	}
+
+	return s
+}
+
+// Result represents aggregated statistics of an operation
+type Result struct {
+	Latencies *Histogram
+	Bytes     int64
+	Failed    int64
+}
+
+// ErrorRate returns share of failed operations
+func (r Result) ErrorRate() float64 {
+	total := r.Latencies.Count() + r.Failed
+	if total == 0 {
+		return 0
+	}
+	return float64(r.Failed) / float64(total)
+}
+
+// Result returns statistics of the operation on all nodes; if operation is
+// empty, statistics of all operations are returned
+func (r *Recorder) Result(operation string) Result {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	result := Result{Latencies: NewHistogram()}
+	for op, nodes := range r.stats {
+		if operation != "" && op != operation {
+			continue
+		}
+		for _, s := range nodes {
+			result.Latencies.Merge(s.latencies)
+			result.Bytes += s.bytes
+			result.Failed += s.failed
+		}
+	}
+
+	return result
+}
+
+// Operations returns sorted names of recorded operations
+func (r *Recorder) Operations() (operations []string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for op := range r.stats {
+		operations = append(operations, op)
+	}
+	sort.Strings(operations)
+	return
+}
+
+// Report prints throughput and latency percentiles of each operation, in
+// total and per node
+func (r *Recorder) Report() {
+	r.mu.Lock()
+	elapsed := r.last.Sub(r.first)
+	r.mu.Unlock()
+
+	for _, op := range r.Operations() {
+		result := r.Result(op)
+		fmt.Printf("%s: %d successful, %d failed, %d bytes in %s\n", op, result.Latencies.Count(), result.Failed, result.Bytes, elapsed)
+		if elapsed > 0 {
+			seconds := elapsed.Seconds()
+			fmt.Printf("%s throughput: %.2f ops/s, %.2f MB/s\n", op, float64(result.Latencies.Count())/seconds, float64(result.Bytes)/seconds/1024/1024)
+		}
+		fmt.Printf("%s latency: %s\n", op, percentiles(result.Latencies))
+
+		r.mu.Lock()
+		nodes := make([]string, 0, len(r.stats[op]))
+		for n := range r.stats[op] {
+			nodes = append(nodes, n)
+		}
+		sort.Strings(nodes)
+		lines := make([]string, 0, len(nodes))
+		for _, n := range nodes {
+			s := r.stats[op][n]
+			lines = append(lines, fmt.Sprintf("%s node %s: %d successful, %d failed, latency: %s", op, n, s.latencies.Count(), s.failed, percentiles(s.latencies)))
+		}
+		r.mu.Unlock()
+
+		for _, l := range lines {
+			fmt.Println(l)
+		}
+	}
+}
+
+// percentiles returns printable latency percentiles of the histogram
+func percentiles(h *Histogram) string {
+	return fmt.Sprintf("p50 %s, p90 %s, p99 %s, max %s", h.Percentile(50), h.Percentile(90), h.Percentile(99), h.Max())
+}
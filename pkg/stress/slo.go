@@ -0,0 +1,132 @@
+package stress
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrSLOViolated is returned when a service level objective is not met
+var ErrSLOViolated = errors.New("service level objective violated")
+
+// Objective represents service level objective, e.g. upload.p99<2s or
+// error_rate<1%
+type Objective struct {
+	Operation string  // operation name, empty for all operations
+	Stat      string  // p50, p90, p99, p999, mean, max or error_rate
+	Inclusive bool    // whether the threshold itself is allowed
+	Threshold float64 // seconds for latency statistics, fraction for error rate
+	raw       string
+}
+
+func (o Objective) String() string {
+	return o.raw
+}
+
+var percentileStats = map[string]float64{
+	"p50":  50,
+	"p90":  90,
+	"p95":  95,
+	"p99":  99,
+	"p999": 99.9,
+}
+
+// ParseSLO parses comma separated service level objectives. Each objective
+// is [operation.]stat<value or [operation.]stat<=value, where stat is a
+// latency percentile (p50, p90, p95, p99, p999), mean or max with a duration
+// value, or error_rate with a percentage or fraction value.
+func ParseSLO(s string) (objectives []Objective, err error) {
+	for _, raw := range strings.Split(s, ",") {
+		raw = strings.TrimSpace(raw)
+		if raw == "" {
+			continue
+		}
+
+		o := Objective{raw: raw}
+		i := strings.Index(raw, "<")
+		if i < 0 {
+			return nil, fmt.Errorf("objective %q: missing < or <=", raw)
+		}
+		metric, value := raw[:i], raw[i+1:]
+		if strings.HasPrefix(value, "=") {
+			o.Inclusive = true
+			value = value[1:]
+		}
+
+		o.Stat = metric
+		if j := strings.LastIndex(metric, "."); j >= 0 {
+			o.Operation, o.Stat = metric[:j], metric[j+1:]
+		}
+
+		switch _, percentile := percentileStats[o.Stat]; {
+		case percentile, o.Stat == "mean", o.Stat == "max":
+			d, err := time.ParseDuration(value)
+			if err != nil {
+				return nil, fmt.Errorf("objective %q: %w", raw, err)
+			}
+			o.Threshold = d.Seconds()
+		case o.Stat == "error_rate":
+			if strings.HasSuffix(value, "%") {
+				v, err := strconv.ParseFloat(strings.TrimSuffix(value, "%"), 64)
+				if err != nil {
+					return nil, fmt.Errorf("objective %q: %w", raw, err)
+				}
+				o.Threshold = v / 100
+			} else if o.Threshold, err = strconv.ParseFloat(value, 64); err != nil {
+				return nil, fmt.Errorf("objective %q: %w", raw, err)
+			}
+		default:
+			return nil, fmt.Errorf("objective %q: unknown statistic %q", raw, o.Stat)
+		}
+
+		objectives = append(objectives, o)
+	}
+
+	return
+}
+
+// CheckSLO checks recorded statistics against service level objectives and
+// returns ErrSLOViolated listing all violated objectives
+func (r *Recorder) CheckSLO(objectives []Objective) error {
+	var violated []string
+	for _, o := range objectives {
+		result := r.Result(o.Operation)
+
+		var have float64
+		var printable string
+		switch o.Stat {
+		case "error_rate":
+			have = result.ErrorRate()
+			printable = fmt.Sprintf("%.2f%%", have*100)
+		case "mean":
+			have = result.Latencies.Mean().Seconds()
+			printable = result.Latencies.Mean().String()
+		case "max":
+			have = result.Latencies.Max().Seconds()
+			printable = result.Latencies.Max().String()
+		default:
+			p := result.Latencies.Percentile(percentileStats[o.Stat])
+			have = p.Seconds()
+			printable = p.String()
+		}
+
+		met := have < o.Threshold || (o.Inclusive && have == o.Threshold)
+		if o.Stat != "error_rate" && result.Latencies.Count() == 0 {
+			met = false
+			printable = "no successful operations"
+		}
+
+		fmt.Printf("slo %s: %s, met: %t\n", o, printable, met)
+		if !met {
+			violated = append(violated, fmt.Sprintf("%s (%s)", o, printable))
+		}
+	}
+
+	if len(violated) > 0 {
+		return fmt.Errorf("%w: %s", ErrSLOViolated, strings.Join(violated, ", "))
+	}
+
+	return nil
+}
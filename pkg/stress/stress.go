@@ -27,8 +27,32 @@ type Options struct {
 	Seed                  int64
 	Timeout               time.Duration
 	UploadNodesPercentage int
+	UploadConcurrency     int // maximum number of nodes uploading at the same time
 	PostageAmount         int64
 	PostageWait           time.Duration
+	// distribution of file chunks relative to overlays of nodes in name order
+	Distribution      bee.Distribution
+	DistributionDepth uint8
+	// download stress options
+	CorpusSize              int
+	DownloadNodesPercentage int
+	Popularity              string
+	ZipfS                   float64
+	// load stress options
+	Rate        float64 // target number of requests per second
+	RampUp      time.Duration
+	Plateau     time.Duration
+	RampDown    time.Duration
+	ReadRatio   float64 // share of read requests, between 0 and 1
+	MaxInFlight int
+	// soak options, soak verification is disabled if interval is not set
+	SoakInterval   time.Duration
+	SoakSamples    int
+	SoakAgeBuckets []time.Duration
+	// Recorder records latencies of operations, it is created if not set
+	Recorder *Recorder
+	// SLO are service level objectives that must be met by recorded operations
+	SLO []Objective
 }
 
 // Stage define stages for updating Bee
@@ -52,6 +76,13 @@ type Actions struct {
 func Run(ctx context.Context, cluster *bee.Cluster, stress Stress, options Options, stages []Stage, seed int64) (err error) {
 	fmt.Printf("root seed: %d\n", seed)
 
+	if options.Recorder == nil {
+		options.Recorder = NewRecorder()
+	}
+	defer func() {
+		err = report(options, err)
+	}()
+
 	if err := stress.Run(ctx, cluster, options); err != nil {
 		return err
 	}
@@ -89,6 +120,13 @@ func Run(ctx context.Context, cluster *bee.Cluster, stress Stress, options Optio
 func RunConcurrently(ctx context.Context, cluster *bee.Cluster, stress Stress, options Options, stages []Stage, buffer int, seed int64) (err error) {
 	fmt.Printf("root seed: %d\n", seed)
 
+	if options.Recorder == nil {
+		options.Recorder = NewRecorder()
+	}
+	defer func() {
+		err = report(options, err)
+	}()
+
 	if err := stress.Run(ctx, cluster, options); err != nil {
 		return err
 	}
@@ -145,6 +183,17 @@ func RunConcurrently(ctx context.Context, cluster *bee.Cluster, stress Stress, o
 	return
 }
 
+// report prints recorded statistics and, if stress succeeded, checks them
+// against service level objectives
+func report(o Options, err error) error {
+	o.Recorder.Report()
+	if err != nil {
+		return err
+	}
+
+	return o.Recorder.CheckSLO(o.SLO)
+}
+
 // updateNodeGroup updates node group by adding, deleting, starting and stopping it's nodes
 func updateNodeGroup(ctx context.Context, ng *bee.NodeGroup, a Actions, rnd *rand.Rand, stage int) (err error) {
 	// get info from the cluster
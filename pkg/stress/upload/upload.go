@@ -1,6 +1,7 @@
 package upload
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -11,6 +12,7 @@ import (
 
 	"github.com/ethersphere/beekeeper/pkg/bee"
 	"github.com/ethersphere/beekeeper/pkg/beeclient/api"
+	"github.com/ethersphere/beekeeper/pkg/ledger"
 	"github.com/ethersphere/beekeeper/pkg/random"
 	"github.com/ethersphere/beekeeper/pkg/stress"
 	"golang.org/x/sync/errgroup"
@@ -20,16 +22,35 @@ import (
 var _ stress.Stress = (*Upload)(nil)
 
 // UploadStress stress
-type Upload struct{}
+type Upload struct {
+	// ledger and durability are kept between runs, so that soak verification
+	// covers content uploaded in all stages
+	ledger     *ledger.Ledger
+	record     bool // uploads are added to the ledger by the stress, not by clients
+	durability *ledger.Durability
+}
+
+// NewUpload returns new upload stress whose soak verification samples content
+// recorded in the given cluster ledger, so that durability history survives
+// restarts; if the ledger is nil, uploads are recorded in memory
+func NewUpload(l *ledger.Ledger) *Upload {
+	if l == nil {
+		return &Upload{
+			ledger: ledger.New(),
+			record: true,
+		}
+	}
 
-// NewUploadStress returns new ping stress
-func NewUpload() *Upload {
-	return &Upload{}
+	return &Upload{
+		ledger: l,
+	}
 }
 
 // Run executes upload stress
 func (u *Upload) Run(ctx context.Context, cluster *bee.Cluster, o stress.Options) (err error) {
-	concurrency := 100
+	if o.UploadConcurrency <= 0 {
+		return fmt.Errorf("upload concurrency must be greater than 0")
+	}
 
 	clients, err := cluster.NodesClients(ctx)
 	if err != nil {
@@ -48,7 +69,22 @@ func (u *Upload) Run(ctx context.Context, cluster *bee.Cluster, o stress.Options
 	rnds := random.PseudoGenerators(rnd.Int63(), nodeCount)
 
 	uGroup := new(errgroup.Group)
-	uSemaphore := make(chan struct{}, concurrency)
+	uSemaphore := make(chan struct{}, o.UploadConcurrency)
+
+	if o.SoakInterval > 0 {
+		if u.durability == nil {
+			u.durability = ledger.NewDurability(o.SoakAgeBuckets)
+		}
+
+		soakRnd := random.PseudoGenerator(rnd.Int63())
+		uGroup.Go(func() error {
+			ctx, ctxCancel := context.WithTimeout(ctx, o.Timeout)
+			defer ctxCancel()
+
+			return u.soak(ctx, clients, nodeNames, soakRnd, o)
+		})
+	}
+
 	for i, p := range picked {
 		i := i
 		p := p
@@ -68,8 +104,33 @@ func (u *Upload) Run(ctx context.Context, cluster *bee.Cluster, o stress.Options
 				return fmt.Errorf("node %s: %w", p, err)
 			}
 
+			dataset, err := stress.NewDataset(ctx, clients, nodeNames, rnds[i].Int63(), o)
+			if err != nil {
+				return fmt.Errorf("node %s: %w", p, err)
+			}
+
 			for {
-				file := bee.NewRandomFile(rnds[i], "filename", o.FileSize)
+				file, err := dataset.File("filename", o.FileSize)
+				if err != nil {
+					return fmt.Errorf("node %s: %w", p, err)
+				}
+
+				// batch is taken once per file, so that failed attempts do not use its capacity
+				batchID, err := n.Batches().Get(ctx, bee.BatchOptions{
+					Amount: o.PostageAmount,
+					Depth:  16,
+					Label:  "test-label",
+					Chunks: bee.CalculateNumberOfChunks(file.Size(), false),
+					Prefix: dataset.Prefix(),
+					Wait:   o.PostageWait,
+				})
+				if err != nil {
+					if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+						return nil
+					}
+					return fmt.Errorf("node %s: batch id %w", p, err)
+				}
+				fmt.Printf("node %s: batch id %s\n", p, batchID)
 
 				retryCount := 0
 				for {
@@ -87,16 +148,33 @@ func (u *Upload) Run(ctx context.Context, cluster *bee.Cluster, o stress.Options
 						return ctx.Err()
 					}
 
-					batchID, err := n.GetOrCreateBatch(ctx, 16, o.PostageWait)
-					if err != nil {
-						return fmt.Errorf("node %s: batch id %w", p, err)
+					// start from the beginning of the file data if the previous attempt failed
+					if err := file.Rewind(); err != nil {
+						return fmt.Errorf("node %s: %w", p, err)
 					}
-					fmt.Printf("node %s: batch id %s\n", p, batchID)
 
+					t := time.Now()
 					if err := n.UploadFile(ctx, &file, api.UploadOptions{BatchID: batchID}); err != nil {
+						if ctx.Err() == nil {
+							o.Recorder.Fail(stress.OperationUpload, p)
+						}
 						fmt.Printf("error: uploading file %s to node %s: %v\n", file.Address().String(), overlay, err)
 						continue
 					}
+					o.Recorder.Record(stress.OperationUpload, p, time.Since(t), file.Size())
+					if !u.record {
+						break
+					}
+					if err := u.ledger.Add(ledger.Entry{
+						Reference: file.Address(),
+						Kind:      ledger.KindFile,
+						Hash:      file.Hash(),
+						Size:      file.Size(),
+						Node:      p,
+						BatchID:   batchID,
+					}); err != nil {
+						return fmt.Errorf("node %s: %w", p, err)
+					}
 					break
 				}
 
@@ -109,12 +187,65 @@ func (u *Upload) Run(ctx context.Context, cluster *bee.Cluster, o stress.Options
 		return err
 	}
 
+	if o.SoakInterval > 0 {
+		u.durability.Report()
+	}
+
 	fmt.Println("upload stress completed successfully")
 	return
 }
 
+// soak periodically downloads samples of previously uploaded content from
+// random nodes other than the uploader and reports durability by age
+func (u *Upload) soak(ctx context.Context, clients map[string]*bee.Client, nodeNames []string, rnd *rand.Rand, o stress.Options) error {
+	for round := 1; ; round++ {
+		select {
+		case <-time.After(o.SoakInterval):
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return nil
+			}
+			return ctx.Err()
+		}
+
+		sample := u.ledger.Sample(rnd, o.SoakSamples)
+		fmt.Printf("soak round %d: verifying %d of %d uploaded entries\n", round, len(sample), u.ledger.Len())
+
+		for _, e := range sample {
+			p := e.Node
+			if len(nodeNames) > 1 {
+				for p == e.Node {
+					p = nodeNames[rnd.Intn(len(nodeNames))]
+				}
+			}
+
+			t := time.Now()
+			size, hash, err := clients[p].DownloadLedgerEntry(ctx, e)
+			if ctx.Err() != nil {
+				return nil
+			}
+			age := time.Since(e.Uploaded)
+
+			retrievable := err == nil && bytes.Equal(hash, e.Hash)
+			u.durability.Record(age, retrievable)
+			if !retrievable {
+				o.Recorder.Fail(stress.OperationSoak, p)
+				if err == nil {
+					err = fmt.Errorf("hash mismatch, downloaded %d bytes, uploaded %d bytes", size, e.Size)
+				}
+				fmt.Printf("soak: %s %s uploaded to node %s %s ago not retrievable from node %s: %v\n", e.Kind, e.Reference, e.Node, age.Round(time.Second), p, err)
+				continue
+			}
+			o.Recorder.Record(stress.OperationSoak, p, time.Since(t), size)
+		}
+
+		u.durability.Report()
+	}
+}
+
 // randomPick randomly picks n elements from the list, and returns lists of picked elements
 func randomPick(rnd *rand.Rand, list []string, n int) (picked []string) {
+	list = append([]string(nil), list...)
 	for i := 0; i < n; i++ {
 		index := rnd.Intn(len(list))
 		picked = append(picked, list[index])